@@ -0,0 +1,331 @@
+// Package check lints a deck: the slides, the assets and the files they
+// reference and the configuration.
+package check
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dgageot/demoit/config"
+	"github.com/dgageot/demoit/deck"
+	"github.com/dgageot/demoit/files"
+	"github.com/dgageot/demoit/git"
+	"github.com/dgageot/demoit/regions"
+	"github.com/dgageot/demoit/terminal"
+)
+
+// assetRegexp finds the root-relative urls of the slides.
+var assetRegexp = regexp.MustCompile(`\b(?:src|href|poster)\s*=\s*["'](/[^"'?#]*)`)
+
+// staticPrefixes are the urls served from the .demoit folder.
+var staticPrefixes = []string{"/images/", "/media/", "/fonts/", "/js/", "/style.css", "/favicon.ico"}
+
+// Diagnostic is a problem found in the deck.
+type Diagnostic struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	if d.Line == 0 {
+		return d.File + ": " + d.Message
+	}
+
+	return fmt.Sprintf("%s:%d: %s", d.File, d.Line, d.Message)
+}
+
+// Run checks a deck, prints the problems found on out and returns how many there are.
+func Run(folder string, out io.Writer) (int, error) {
+	diagnostics, err := Deck(folder)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, diagnostic := range diagnostics {
+		fmt.Fprintln(out, diagnostic)
+	}
+	if len(diagnostics) == 0 {
+		fmt.Fprintln(out, "No problems found")
+	}
+
+	return len(diagnostics), nil
+}
+
+// Deck checks the configuration and all the slides of a deck.
+func Deck(folder string) ([]Diagnostic, error) {
+	var diagnostics []Diagnostic
+
+	if err := config.Current.Validate(); err != nil {
+		for line := range strings.SplitSeq(err.Error(), "\n") {
+			message := strings.TrimPrefix(line, config.FileName+": ")
+			diagnostics = append(diagnostics, Diagnostic{File: config.FileName, Message: message})
+		}
+	}
+
+	if info, err := os.Stat(filepath.Join(folder, ".demoit")); err != nil || !info.IsDir() {
+		diagnostics = append(diagnostics, Diagnostic{File: ".demoit", Message: "folder is missing"})
+	}
+
+	slides, err := deck.Read(folder)
+	if err != nil {
+		return nil, err
+	}
+
+	var slideDiagnostics []Diagnostic
+	for _, slide := range slides {
+		slideDiagnostics = append(slideDiagnostics, checkAssets(folder, slide)...)
+		for _, element := range slide.Elements("source-code") {
+			slideDiagnostics = append(slideDiagnostics, checkSourceCode(element)...)
+		}
+		for _, element := range slide.Elements("web-term") {
+			slideDiagnostics = append(slideDiagnostics, checkWebTerm(element)...)
+		}
+		for _, element := range slide.Elements("web-browser") {
+			slideDiagnostics = append(slideDiagnostics, checkWebBrowser(element)...)
+		}
+		for _, element := range slide.Elements("git-checkout") {
+			slideDiagnostics = append(slideDiagnostics, checkGitCheckout(element)...)
+		}
+	}
+	slices.SortStableFunc(slideDiagnostics, func(a, b Diagnostic) int { return a.Line - b.Line })
+
+	return append(diagnostics, slideDiagnostics...), nil
+}
+
+func diagnostic(line int, format string, args ...any) Diagnostic {
+	return Diagnostic{File: deck.FileName, Line: line, Message: fmt.Sprintf(format, args...)}
+}
+
+// checkAssets checks that the images, media, fonts and scripts
+// used by a slide exist in the .demoit folder.
+func checkAssets(folder string, slide deck.Slide) []Diagnostic {
+	var diagnostics []Diagnostic
+
+	for _, match := range assetRegexp.FindAllSubmatchIndex(slide.Content, -1) {
+		path, err := url.PathUnescape(string(slide.Content[match[2]:match[3]]))
+		if err != nil || !isStatic(path) {
+			continue
+		}
+
+		if _, err := os.Stat(filepath.Join(folder, ".demoit", filepath.FromSlash(path))); err != nil {
+			line := slide.Line + bytes.Count(slide.Content[:match[0]], []byte("\n"))
+			diagnostics = append(diagnostics, diagnostic(line, "asset %s not found in .demoit", path))
+		}
+	}
+
+	return diagnostics
+}
+
+func isStatic(path string) bool {
+	for _, prefix := range staticPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkSourceCode checks that the folder and the files of a <source-code>
+// exist and that there are highlighted lines, inside the file, for each file.
+func checkSourceCode(element deck.Element) []Diagnostic {
+	var diagnostics []Diagnostic
+	report := func(format string, args ...any) {
+		diagnostics = append(diagnostics, diagnostic(element.Line, "<source-code> "+format, args...))
+	}
+
+	folder := element.Attrs["folder"]
+	if !isDir(folder) {
+		report("folder %q not found", folder)
+		return diagnostics
+	}
+
+	names := strings.Fields(element.Attrs["files"])
+	if len(names) == 0 {
+		report("has no files")
+		return diagnostics
+	}
+
+	startLines, hasStart := element.Attrs["start-lines"]
+	endLines, hasEnd := element.Attrs["end-lines"]
+	if hasStart != hasEnd {
+		report("start-lines and end-lines go together")
+		return diagnostics
+	}
+
+	starts := make([]string, len(names))
+	ends := make([]string, len(names))
+	if hasStart {
+		starts = strings.Split(startLines, ";")
+		ends = strings.Split(endLines, ";")
+		if len(starts) != len(names) || len(ends) != len(names) {
+			report("%d files but %d start-lines and %d end-lines", len(names), len(starts), len(ends))
+			return diagnostics
+		}
+	}
+
+	highlights := strings.Split(element.Attrs["highlights"], ";")
+	if len(highlights) > len(names) {
+		report("%d files but %d highlights", len(names), len(highlights))
+	}
+	regionSpecs := strings.Split(element.Attrs["regions"], ";")
+	if len(regionSpecs) > len(names) {
+		report("%d files but %d regions", len(names), len(regionSpecs))
+	}
+
+	for i, name := range names {
+		content, err := readFile(folder, name)
+		if err != nil {
+			report("file %s not found in %s", name, folder)
+			continue
+		}
+
+		for _, problem := range checkRanges(starts[i], ends[i], regions.LineCount(content)) {
+			report("%s: %s", name, problem)
+		}
+
+		file, _, _ := strings.Cut(name, "@")
+		if i < len(regionSpecs) && regionSpecs[i] != "" {
+			if _, err := regions.Parse(file, content, regionSpecs[i]); err != nil {
+				report("%s: region %s: %v", name, regionSpecs[i], err)
+			}
+		}
+		if i >= len(highlights) {
+			continue
+		}
+		for _, highlight := range strings.Split(highlights[i], ",") {
+			highlight = strings.TrimSpace(highlight)
+			if highlight == "" {
+				continue
+			}
+			if _, err := regions.Find(file, content, highlight); err != nil {
+				report("%s: %v", name, err)
+			}
+		}
+	}
+
+	diffs := strings.Fields(element.Attrs["diff"])
+	if len(diffs) > len(names) {
+		report("%d files but %d diffs", len(names), len(diffs))
+	}
+	for _, other := range diffs {
+		if other == "-" || strings.HasPrefix(other, "@") {
+			continue
+		}
+		if _, err := files.Read(folder, other); err != nil {
+			report("diff file %s not found in %s", other, folder)
+		}
+	}
+
+	return diagnostics
+}
+
+// readFile reads a file of a <source-code>, at a git revision for file@rev.
+func readFile(folder, name string) ([]byte, error) {
+	if at := strings.LastIndex(name, "@"); at > 0 {
+		return git.Show(files.Path(filepath.Join(folder, name[:at])), name[at+1:])
+	}
+
+	return files.Read(folder, name)
+}
+
+// checkRanges checks the comma separated start and end lines of the
+// highlighted ranges of a file.
+func checkRanges(startLines, endLines string, lineCount int) []string {
+	if strings.TrimSpace(startLines) == "" && strings.TrimSpace(endLines) == "" {
+		return nil
+	}
+
+	starts := strings.Split(startLines, ",")
+	ends := strings.Split(endLines, ",")
+	if len(starts) != len(ends) {
+		return []string{fmt.Sprintf("%d start lines but %d end lines", len(starts), len(ends))}
+	}
+
+	var problems []string
+	for i := range starts {
+		start, errStart := strconv.Atoi(strings.TrimSpace(starts[i]))
+		end, errEnd := strconv.Atoi(strings.TrimSpace(ends[i]))
+		switch {
+		case errStart != nil || errEnd != nil:
+			problems = append(problems, fmt.Sprintf("invalid range %s-%s", starts[i], ends[i]))
+		case start < 1 || start > end:
+			problems = append(problems, fmt.Sprintf("invalid range %d-%d", start, end))
+		case end > lineCount:
+			problems = append(problems, fmt.Sprintf("range %d-%d is out of the file's %d lines", start, end, lineCount))
+		}
+	}
+
+	return problems
+}
+
+// checkWebTerm checks that the folder of a <web-term> exists and that
+// its terminal can be started: profile, shell, host, recording...
+func checkWebTerm(element deck.Element) []Diagnostic {
+	var diagnostics []Diagnostic
+
+	path := element.Attrs["path"]
+	if element.Attrs["host"] == "" && !isDir(path) {
+		diagnostics = append(diagnostics, diagnostic(element.Line, "<web-term> folder %q not found", path))
+	}
+
+	if _, err := terminal.Options(terminal.Query(element.Attrs)); err != nil {
+		diagnostics = append(diagnostics, diagnostic(element.Line, "<web-term> %s", err))
+	}
+
+	return diagnostics
+}
+
+// checkWebBrowser checks that a <web-browser> has a valid url,
+// absolute or under /proxy/ for one of the configured proxies.
+func checkWebBrowser(element deck.Element) []Diagnostic {
+	src := element.Attrs["src"]
+	u, err := url.Parse(src)
+	if err != nil {
+		return []Diagnostic{diagnostic(element.Line, "<web-browser> %q is not a valid url", src)}
+	}
+	if u.Host == "" && strings.HasPrefix(u.Path, "/proxy/") {
+		if u = config.Current.Unproxied(u); u.Host == "" {
+			return []Diagnostic{diagnostic(element.Line, "<web-browser> %q is not a configured proxy", src)}
+		}
+	}
+	if u.Host == "" {
+		return []Diagnostic{diagnostic(element.Line, "<web-browser> %q is not a valid url", src)}
+	}
+
+	return nil
+}
+
+// checkGitCheckout checks that a <git-checkout> ref exists in the demo working tree.
+func checkGitCheckout(element deck.Element) []Diagnostic {
+	ref := element.Attrs["ref"]
+	worktree := config.Current.Git.Worktree
+	switch {
+	case ref == "":
+		return []Diagnostic{diagnostic(element.Line, "<git-checkout> has no ref")}
+	case worktree == "":
+		return []Diagnostic{diagnostic(element.Line, "<git-checkout> needs git.worktree in %s", config.FileName)}
+	case !isDir(worktree):
+		return nil // Reported by the configuration checks.
+	}
+
+	if _, err := git.Resolve(files.Path(worktree), ref); err != nil {
+		return []Diagnostic{diagnostic(element.Line, "<git-checkout> ref %q not found in %s", ref, worktree)}
+	}
+
+	return nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(files.Path(path))
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,128 @@
+// Package codestyle registers the chroma styles shipped by a deck, in
+// .demoit/styles, next to the styles built into chroma.
+package codestyle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/styles"
+	"gopkg.in/yaml.v3"
+)
+
+// Folder is the folder of a deck with its styles.
+var Folder = filepath.Join(".demoit", "styles")
+
+var (
+	lock   sync.RWMutex
+	custom = map[string]*chroma.Style{}
+)
+
+// palette is a style defined in YAML: token types, e.g. Keyword or
+// NameFunction, and their chroma style entries, e.g. "bold #0055aa".
+type palette struct {
+	Name   string            `yaml:"name"`
+	Styles map[string]string `yaml:"styles"`
+}
+
+// Load reads the styles of a deck, chroma XML (.xml) or YAML palettes
+// (.yaml or .yml), replacing the styles loaded before. The name of a
+// palette is its declared name or the name of its file. It returns the
+// names of the styles and the problems found with the files, if any.
+func Load(root string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(root, Folder))
+	if errors.Is(err, os.ErrNotExist) {
+		entries = nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	loaded := map[string]*chroma.Style{}
+	var (
+		names []string
+		errs  []error
+	)
+	for _, entry := range entries {
+		path := filepath.Join(Folder, entry.Name())
+		if entry.IsDir() {
+			continue
+		}
+
+		style, err := read(filepath.Join(root, path))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", path, err))
+			continue
+		}
+		if style == nil {
+			continue
+		}
+
+		loaded[strings.ToLower(style.Name)] = style
+		names = append(names, style.Name)
+	}
+
+	lock.Lock()
+	custom = loaded
+	lock.Unlock()
+
+	return names, errors.Join(errs...)
+}
+
+// read reads a style file, or returns nil if it's not a style.
+func read(path string) (*chroma.Style, error) {
+	ext := filepath.Ext(path)
+	if ext != ".xml" && ext != ".yaml" && ext != ".yml" {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if ext == ".xml" {
+		return chroma.NewXMLStyle(bytes.NewReader(content))
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), ext)
+	var p palette
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&p); err != nil {
+		return nil, err
+	}
+	if p.Name != "" {
+		name = p.Name
+	}
+
+	entries := chroma.StyleEntries{}
+	for typeName, entry := range p.Styles {
+		tokenType, err := chroma.TokenTypeString(typeName)
+		if err != nil {
+			return nil, fmt.Errorf("unknown token type %q", typeName)
+		}
+		entries[tokenType] = entry
+	}
+
+	return chroma.NewStyle(name, entries)
+}
+
+// Get returns a style of the deck or a style built into chroma, by name.
+func Get(name string) (*chroma.Style, bool) {
+	name = strings.ToLower(name)
+
+	lock.RLock()
+	style, found := custom[name]
+	lock.RUnlock()
+	if found {
+		return style, true
+	}
+
+	style, found = styles.Registry[name]
+	return style, found
+}
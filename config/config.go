@@ -0,0 +1,368 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the optional configuration file at the root of a deck.
+const FileName = "demoit.yaml"
+
+// Current is the configuration of the deck being presented.
+var Current = Default()
+
+// Config is the configuration of a deck.
+type Config struct {
+	Port       int              `yaml:"port"`
+	Host       string           `yaml:"host"`
+	Dev        bool             `yaml:"dev"`
+	Code       Code             `yaml:"code"`
+	Terminal   Terminal         `yaml:"terminal"`
+	Proxy      map[string]Proxy `yaml:"proxy"`
+	Hosts      map[string]Host  `yaml:"hosts"`
+	Recordings string           `yaml:"recordings"`
+	Git        Git              `yaml:"git"`
+	Security   Security         `yaml:"security"`
+}
+
+// Code configures the code viewer.
+type Code struct {
+	Style string `yaml:"style"`
+	// Run has the commands run by the run button of the code viewer,
+	// by file pattern, e.g. "*.go": go run $FILE. A pattern with a /
+	// matches the path of the file in the deck, otherwise its name.
+	Run map[string]string `yaml:"run"`
+	// Languages has the chroma lexers of the files that are not recognized
+	// by their name, by file pattern, e.g. "Dockerfile.*": docker.
+	Languages map[string]string `yaml:"languages"`
+	// StringValues lists the languages, e.g. yaml, whose plain text
+	// values are colored as strings.
+	StringValues []string `yaml:"string_values"`
+}
+
+// RunCommand returns the command that runs a file, given its path in the deck.
+// The longest matching pattern wins.
+func (c Code) RunCommand(file string) (string, bool) {
+	return bestMatch(c.Run, file)
+}
+
+// Language returns the configured language of a file, given its path in the deck.
+// The longest matching pattern wins.
+func (c Code) Language(file string) (string, bool) {
+	return bestMatch(c.Languages, file)
+}
+
+// bestMatch returns the value of the longest file pattern that matches a file.
+// A pattern with a / matches the path of the file, otherwise its name.
+func bestMatch(values map[string]string, file string) (string, bool) {
+	var best string
+	for pattern := range values {
+		name := filepath.Base(file)
+		if strings.Contains(pattern, "/") {
+			name = filepath.ToSlash(filepath.Clean(file))
+		}
+		if matched, _ := filepath.Match(pattern, name); !matched {
+			continue
+		}
+		if len(pattern) > len(best) || (len(pattern) == len(best) && pattern < best) {
+			best = pattern
+		}
+	}
+	if best == "" {
+		return "", false
+	}
+
+	return values[best], true
+}
+
+// Terminal configures the web terminals.
+type Terminal struct {
+	Shell    string             `yaml:"shell"`
+	RC       string             `yaml:"rc"`
+	History  string             `yaml:"history"`
+	Env      map[string]string  `yaml:"env"`
+	ScrubEnv []string           `yaml:"scrub_env"`
+	Profiles map[string]Profile `yaml:"profiles"`
+	// ShutdownGrace is how long terminals have to exit after
+	// SIGHUP on shutdown, before they are killed.
+	ShutdownGrace time.Duration `yaml:"shutdown_grace"`
+	// KeepBackgroundJobs leaves the background jobs of a terminal
+	// running when it's closed. They are still terminated on shutdown.
+	KeepBackgroundJobs bool `yaml:"keep_background_jobs"`
+	// MaxSessions limits the number of terminals open at the same time.
+	// Zero means no limit.
+	MaxSessions int `yaml:"max_sessions"`
+	// IdleTimeout closes terminals that received no input for that long.
+	// Zero means no timeout.
+	IdleTimeout time.Duration `yaml:"idle_timeout"`
+	// MaxDuration closes terminals open for that long. Zero means no limit.
+	MaxDuration time.Duration `yaml:"max_duration"`
+	// Sandbox isolates the terminals from the host.
+	Sandbox Sandbox `yaml:"sandbox"`
+}
+
+// Sandbox configures sandboxed terminals: the shell runs in new Linux
+// namespaces with a copy-on-write view of the demo folder. The limits
+// are applied with cgroups v2, when available.
+type Sandbox struct {
+	// Enabled sandboxes all the terminals. Profiles can also be sandboxed,
+	// then the terminals must all use a sandboxed profile.
+	Enabled bool `yaml:"enabled"`
+	// MemoryMax is the memory limit, e.g. 512M.
+	MemoryMax string `yaml:"memory_max"`
+	// PidsMax is the maximum number of processes.
+	PidsMax int `yaml:"pids_max"`
+	// CPUMax is the cpu.max quota and period, e.g. "50000 100000" for half a CPU.
+	CPUMax string `yaml:"cpu_max"`
+	// AllowHosts allows the shells on remote hosts, which are not sandboxed,
+	// when some terminals are.
+	AllowHosts bool `yaml:"allow_hosts"`
+}
+
+// Profile is a named terminal configuration selected with <web-term profile="...">.
+type Profile struct {
+	Shell   string            `yaml:"shell"`
+	Env     map[string]string `yaml:"env"`
+	Sandbox bool              `yaml:"sandbox"`
+}
+
+// Proxy is a local target served under /proxy/{name}/.
+type Proxy struct {
+	Target       string   `yaml:"target"`
+	StripHeaders []string `yaml:"strip_headers"`
+}
+
+// Git configures the git-driven steps: showing a slide with a
+// <git-checkout ref="..."> resets the demo working tree to that ref.
+type Git struct {
+	// Worktree is the demo working tree. Use a separate working tree,
+	// e.g. with git worktree add, so that the slides are not checked out too.
+	// It can't be the deck folder or one of its parents.
+	Worktree string `yaml:"worktree"`
+	// Uncommitted tells what to do with uncommitted changes before a checkout:
+	// refuse (the default), stash or discard.
+	Uncommitted string `yaml:"uncommitted"`
+}
+
+// Security restricts what the presentation server can do.
+type Security struct {
+	PingAllowlist []string `yaml:"ping_allowlist"`
+}
+
+// Default returns the configuration used when there's no demoit.yaml.
+func Default() *Config {
+	return &Config{
+		Port: 8888,
+		Host: "localhost",
+		Code: Code{
+			Style: "vs",
+		},
+		Terminal: Terminal{
+			ShutdownGrace: 3 * time.Second,
+		},
+	}
+}
+
+// Load reads the demoit.yaml file found in a folder.
+// Missing values keep their default value.
+func Load(folder string) (*Config, error) {
+	config := Default()
+
+	content, err := os.ReadFile(filepath.Join(folder, FileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unable to parse %s: %w", FileName, err)
+	}
+
+	return config, nil
+}
+
+// defaultScrubEnv are the patterns of inherited environment variables
+// that are removed from the terminals, unless configured otherwise.
+var defaultScrubEnv = []string{
+	"*TOKEN*",
+	"*SECRET*",
+	"*PASSWORD*",
+	"*PASSWD*",
+	"*API_KEY*",
+	"*ACCESS_KEY*",
+	"*PRIVATE_KEY*",
+	"*CREDENTIALS*",
+}
+
+// ScrubPatterns are the patterns of inherited environment variables
+// that are removed from the terminals.
+func (t Terminal) ScrubPatterns() []string {
+	if t.ScrubEnv != nil {
+		return t.ScrubEnv
+	}
+
+	return defaultScrubEnv
+}
+
+// RCFile is the path to the rc file sourced by the terminals.
+func (t Terminal) RCFile() string {
+	if t.RC != "" {
+		return t.RC
+	}
+
+	return ".demoit/.bashrc"
+}
+
+// HistoryFile is the path to the history file preloaded in the terminals.
+func (t Terminal) HistoryFile() string {
+	if t.History != "" {
+		return t.History
+	}
+
+	return ".demoit/.bash_history"
+}
+
+// Sandboxed tells if some of the terminals, or all of them, are sandboxed.
+func (t Terminal) Sandboxed() bool {
+	if t.Sandbox.Enabled {
+		return true
+	}
+	for _, profile := range t.Profiles {
+		if profile.Sandbox {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseHost parses an url or a host[:port] with no scheme.
+func ParseHost(entry string) (*url.URL, error) {
+	if !strings.Contains(entry, "://") {
+		entry = "//" + entry
+	}
+
+	return url.Parse(entry)
+}
+
+// ErrUnknownProxy is returned for proxies missing from the configuration.
+var ErrUnknownProxy = errors.New("unknown proxy")
+
+// ProxyTarget returns the local url a proxy forwards to.
+// The url always ends with a /.
+func (c *Config) ProxyTarget(name string) (*url.URL, error) {
+	proxy, found := c.Proxy[name]
+	if !found {
+		return nil, ErrUnknownProxy
+	}
+
+	u, err := url.Parse(proxy.Target)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+
+	return u, nil
+}
+
+// Unproxied converts a /proxy/{name}/ url back to the target url.
+// Other urls are returned unchanged.
+func (c *Config) Unproxied(u *url.URL) *url.URL {
+	path, found := strings.CutPrefix(u.Path, "/proxy/")
+	if !found || u.Host != "" {
+		return u
+	}
+
+	name, rest, _ := strings.Cut(path, "/")
+	target, err := c.ProxyTarget(name)
+	if err != nil {
+		return u
+	}
+
+	return target.ResolveReference(&url.URL{Path: rest, RawQuery: u.RawQuery})
+}
+
+// Address is the address to bind the presentation web server.
+func (c *Config) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// Host is a remote demo host where <web-term host="..."> opens an SSH session.
+type Host struct {
+	// Address is the host name, with an optional port. The default port is 22.
+	Address string `yaml:"address"`
+	User    string `yaml:"user"`
+	// Key is a private key file. Without a key, the ssh-agent is used.
+	Key string `yaml:"key"`
+	// KnownHosts is the file used to check the host's key, ~/.ssh/known_hosts by default.
+	KnownHosts string `yaml:"known_hosts"`
+	// InsecureIgnoreHostKey skips the host key check, e.g. for a throwaway VM.
+	InsecureIgnoreHostKey bool `yaml:"insecure_ignore_host_key"`
+	// Shell is the remote shell, bash by default.
+	Shell string `yaml:"shell"`
+	// Dir is the remote folder the terminals start in. Defaults to the home folder.
+	Dir string `yaml:"dir"`
+}
+
+// KeyFile is the path to the private key file, if any, with ~ expanded.
+func (h Host) KeyFile() string {
+	return expandHome(h.Key)
+}
+
+// KnownHostsFile is the path to the known hosts file, with ~ expanded.
+func (h Host) KnownHostsFile() string {
+	if h.KnownHosts != "" {
+		return expandHome(h.KnownHosts)
+	}
+
+	return expandHome("~/.ssh/known_hosts")
+}
+
+// SSHAddress is the host:port to connect to.
+func (h Host) SSHAddress() string {
+	if _, _, err := net.SplitHostPort(h.Address); err == nil {
+		return h.Address
+	}
+
+	return net.JoinHostPort(h.Address, "22")
+}
+
+func expandHome(path string) string {
+	rest, found := strings.CutPrefix(path, "~/")
+	if !found {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, rest)
+}
+
+// RecordingsFolder is the folder with the asciicast recordings replayed by the terminals.
+func (c *Config) RecordingsFolder() string {
+	if c.Recordings != "" {
+		return c.Recordings
+	}
+
+	return ".demoit/recordings"
+}
@@ -0,0 +1,217 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/dgageot/demoit/codestyle"
+	"github.com/dgageot/demoit/files"
+	"github.com/dgageot/demoit/git"
+)
+
+var (
+	proxyNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+	cpuMaxRegexp    = regexp.MustCompile(`^(max|[0-9]+)( [0-9]+)?$`)
+	variableRegexp  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// Validate checks the configuration and reports all the problems found.
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(field string, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", FileName, field, fmt.Sprintf(format, args...)))
+	}
+
+	if c.Port <= 0 || c.Port > 65535 {
+		invalid("port", "%d is not a valid port", c.Port)
+	}
+	if c.Host == "" {
+		invalid("host", "should not be empty")
+	}
+
+	if _, found := codestyle.Get(c.Code.Style); !found {
+		invalid("code.style", "unknown style %q", c.Code.Style)
+	}
+
+	for _, pattern := range slices.Sorted(maps.Keys(c.Code.Run)) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			invalid("code.run", "%q is not a valid pattern", pattern)
+		}
+		if strings.TrimSpace(c.Code.Run[pattern]) == "" {
+			invalid("code.run."+pattern, "should not be empty")
+		}
+	}
+
+	for _, pattern := range slices.Sorted(maps.Keys(c.Code.Languages)) {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			invalid("code.languages", "%q is not a valid pattern", pattern)
+		}
+		if lexers.Get(c.Code.Languages[pattern]) == nil {
+			invalid("code.languages."+pattern, "unknown language %q", c.Code.Languages[pattern])
+		}
+	}
+	for _, language := range c.Code.StringValues {
+		if lexers.Get(language) == nil {
+			invalid("code.string_values", "unknown language %q", language)
+		}
+	}
+
+	if c.Terminal.Shell != "" {
+		if _, err := exec.LookPath(c.Terminal.Shell); err != nil {
+			invalid("terminal.shell", "%q can't be found", c.Terminal.Shell)
+		}
+	}
+	if c.Terminal.RC != "" && !isFile(c.Terminal.RC) {
+		invalid("terminal.rc", "file %q doesn't exist", c.Terminal.RC)
+	}
+	if c.Terminal.History != "" && !isFile(c.Terminal.History) {
+		invalid("terminal.history", "file %q doesn't exist", c.Terminal.History)
+	}
+	for name := range c.Terminal.Env {
+		if !isVariableName(name) {
+			invalid("terminal.env", "%q is not a valid variable name", name)
+		}
+	}
+	if c.Terminal.ShutdownGrace < 0 {
+		invalid("terminal.shutdown_grace", "should not be negative")
+	}
+	if c.Terminal.MaxSessions < 0 {
+		invalid("terminal.max_sessions", "should not be negative")
+	}
+	if c.Terminal.IdleTimeout < 0 {
+		invalid("terminal.idle_timeout", "should not be negative")
+	}
+	if c.Terminal.MaxDuration < 0 {
+		invalid("terminal.max_duration", "should not be negative")
+	}
+	for _, pattern := range c.Terminal.ScrubEnv {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			invalid("terminal.scrub_env", "%q is not a valid pattern", pattern)
+		}
+	}
+	if c.Terminal.Sandbox.PidsMax < 0 {
+		invalid("terminal.sandbox.pids_max", "should not be negative")
+	}
+	if c.Terminal.Sandbox.CPUMax != "" && !cpuMaxRegexp.MatchString(c.Terminal.Sandbox.CPUMax) {
+		invalid("terminal.sandbox.cpu_max", "%q should be a quota and a period, e.g. \"50000 100000\"", c.Terminal.Sandbox.CPUMax)
+	}
+	if c.Terminal.Sandbox.Enabled && runtime.GOOS != "linux" {
+		invalid("terminal.sandbox.enabled", "sandboxed terminals are only supported on Linux")
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.Terminal.Profiles)) {
+		profile := c.Terminal.Profiles[name]
+		if profile.Sandbox && runtime.GOOS != "linux" {
+			invalid("terminal.profiles."+name+".sandbox", "sandboxed terminals are only supported on Linux")
+		}
+		if profile.Shell != "" {
+			if _, err := exec.LookPath(profile.Shell); err != nil {
+				invalid("terminal.profiles."+name+".shell", "%q can't be found", profile.Shell)
+			}
+		}
+		for variable := range profile.Env {
+			if !isVariableName(variable) {
+				invalid("terminal.profiles."+name+".env", "%q is not a valid variable name", variable)
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.Proxy)) {
+		proxy := c.Proxy[name]
+		if !proxyNameRegexp.MatchString(name) {
+			invalid("proxy."+name, "name should only contain letters, digits, '.', '-' or '_'")
+		}
+		target, err := url.Parse(proxy.Target)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			invalid("proxy."+name+".target", "%q is not an http or https url", proxy.Target)
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.Hosts)) {
+		host := c.Hosts[name]
+		if host.Address == "" {
+			invalid("hosts."+name+".address", "is required")
+		}
+		if host.User == "" {
+			invalid("hosts."+name+".user", "is required")
+		}
+		if host.Key != "" && !isFile(host.KeyFile()) {
+			invalid("hosts."+name+".key", "file %q doesn't exist", host.Key)
+		}
+		if !host.InsecureIgnoreHostKey && !isFile(host.KnownHostsFile()) {
+			invalid("hosts."+name+".known_hosts", "file %q doesn't exist", host.KnownHostsFile())
+		}
+	}
+
+	if c.Recordings != "" && !isDir(c.Recordings) {
+		invalid("recordings", "folder %q doesn't exist", c.Recordings)
+	}
+
+	if c.Git.Worktree != "" && !isDir(c.Git.Worktree) {
+		invalid("git.worktree", "folder %q doesn't exist", c.Git.Worktree)
+	} else if c.Git.Worktree != "" && containsRoot(c.Git.Worktree) {
+		invalid("git.worktree", "folder %q contains the slides, use a separate working tree", c.Git.Worktree)
+	}
+	switch c.Git.Uncommitted {
+	case "", git.Refuse, git.Stash, git.Discard:
+	default:
+		invalid("git.uncommitted", "%q should be refuse, stash or discard", c.Git.Uncommitted)
+	}
+
+	for _, entry := range c.Security.PingAllowlist {
+		if u, err := ParseHost(entry); err != nil || u.Host == "" {
+			invalid("security.ping_allowlist", "%q is not a host or an url", entry)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func isVariableName(name string) bool {
+	return variableRegexp.MatchString(name)
+}
+
+// containsRoot tests if a folder is the root folder or one of its parents,
+// after resolving the symlinks.
+func containsRoot(path string) bool {
+	dir, err := resolvePath(files.Path(path))
+	if err != nil {
+		return false
+	}
+	root, err := resolvePath(files.Root)
+	if err != nil {
+		return false
+	}
+
+	rel, err := filepath.Rel(dir, root)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func resolvePath(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.EvalSymlinks(path)
+}
+
+func isFile(path string) bool {
+	info, err := os.Stat(files.Path(path))
+	return err == nil && !info.IsDir()
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(files.Path(path))
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,41 @@
+package deck
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+)
+
+// Element is a web component found in the slides.
+type Element struct {
+	Tag   string
+	Attrs map[string]string
+	Line  int
+}
+
+var attrRegexp = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+)))?`)
+
+// Elements lists the opening tags of a given element, in order of appearance.
+// The attribute values are unescaped, like the browser does.
+func Elements(content []byte, tag string) []Element {
+	// A > in a quoted attribute value doesn't end the tag.
+	tagRegexp := regexp.MustCompile(`(?i)<` + regexp.QuoteMeta(tag) + `(\s(?:[^>"']|"[^"]*"|'[^']*')*)?>`)
+
+	var elements []Element
+	for _, match := range tagRegexp.FindAllSubmatchIndex(content, -1) {
+		attrs := map[string]string{}
+		if match[2] >= 0 {
+			for _, attr := range attrRegexp.FindAllSubmatch(content[match[2]:match[3]], -1) {
+				attrs[string(attr[1])] = html.UnescapeString(string(attr[2]) + string(attr[3]) + string(attr[4]))
+			}
+		}
+
+		elements = append(elements, Element{
+			Tag:   tag,
+			Attrs: attrs,
+			Line:  1 + bytes.Count(content[:match[0]], []byte("\n")),
+		})
+	}
+
+	return elements
+}
@@ -0,0 +1,58 @@
+package deck
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestElements(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []map[string]string
+	}{
+		{
+			name:     "no attributes",
+			content:  `<web-term></web-term>`,
+			expected: []map[string]string{{}},
+		},
+		{
+			name:     "quotes",
+			content:  `<web-term path="src" profile='sb' keep-jobs tmux=demo>`,
+			expected: []map[string]string{{"path": "src", "profile": "sb", "keep-jobs": "", "tmux": "demo"}},
+		},
+		{
+			name:     "> in a value",
+			content:  `<web-term script="cat f; echo x > /tmp/y" profile="sb" expect='^a>b$'>`,
+			expected: []map[string]string{{"script": "cat f; echo x > /tmp/y", "profile": "sb", "expect": "^a>b$"}},
+		},
+		{
+			name:     "escaped value",
+			content:  `<web-term script="make &amp;&amp; echo &quot;ok&quot;">`,
+			expected: []map[string]string{{"script": `make && echo "ok"`}},
+		},
+		{
+			name:     "several lines",
+			content:  "<web-term\n  path=\"a\"\n></web-term>\n<WEB-TERM path=\"b\">",
+			expected: []map[string]string{{"path": "a"}, {"path": "b"}},
+		},
+		{
+			name:    "other tag",
+			content: `<web-terminal path="a">`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			elements := Elements([]byte(test.content), "web-term")
+
+			if len(elements) != len(test.expected) {
+				t.Fatalf("got %d elements, expected %d", len(elements), len(test.expected))
+			}
+			for i, element := range elements {
+				if !maps.Equal(element.Attrs, test.expected[i]) {
+					t.Errorf("got %v, expected %v", element.Attrs, test.expected[i])
+				}
+			}
+		})
+	}
+}
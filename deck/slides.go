@@ -0,0 +1,55 @@
+package deck
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+)
+
+// FileName is the name of the file with all the slides of a deck.
+const FileName = "demoit.html"
+
+// Separator separates the slides.
+var Separator = []byte("---")
+
+// Slide is a part of demoit.html, between two separators.
+type Slide struct {
+	Content []byte
+	// Line is the line of demoit.html where the slide starts.
+	Line int
+}
+
+// Read reads the slides of the deck found in a folder.
+func Read(folder string) ([]Slide, error) {
+	content, err := os.ReadFile(filepath.Join(folder, FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	return Slides(content), nil
+}
+
+// Slides splits the content of demoit.html into slides.
+func Slides(content []byte) []Slide {
+	parts := bytes.Split(content, Separator)
+
+	slides := make([]Slide, len(parts))
+	line := 1
+	for i, part := range parts {
+		slides[i] = Slide{Content: part, Line: line}
+		line += bytes.Count(part, []byte("\n"))
+	}
+
+	return slides
+}
+
+// Elements lists the opening tags of a given element in a slide,
+// with their line number in demoit.html.
+func (s Slide) Elements(tag string) []Element {
+	elements := Elements(s.Content, tag)
+	for i := range elements {
+		elements[i].Line += s.Line - 1
+	}
+
+	return elements
+}
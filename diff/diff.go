@@ -0,0 +1,145 @@
+// Package diff computes line based diffs.
+package diff
+
+import "slices"
+
+// Kind tells if a line is common to both versions, added or removed.
+type Kind int
+
+const (
+	Equal Kind = iota
+	Added
+	Removed
+)
+
+// Line is a line of a diff. Old and New are the 1-based line numbers
+// in each version, or 0 if the line isn't part of that version.
+type Line struct {
+	Kind Kind
+	Old  int
+	New  int
+}
+
+// maxEdits bounds the number of added and removed lines searched for. The
+// memory used grows with its square. Past that, the files are considered
+// completely different.
+const maxEdits = 1000
+
+// Lines computes the shortest list of added and removed lines that
+// turn old into new, with Myers' O(ND) algorithm.
+func Lines(old, new []string) []Line {
+	// Skip the common prefix and suffix, the diff is usually small.
+	prefix := 0
+	for prefix < len(old) && prefix < len(new) && old[prefix] == new[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(old)-prefix && suffix < len(new)-prefix && old[len(old)-1-suffix] == new[len(new)-1-suffix] {
+		suffix++
+	}
+
+	var lines []Line
+	for i := range prefix {
+		lines = append(lines, Line{Kind: Equal, Old: i + 1, New: i + 1})
+	}
+
+	lines = append(lines, myers(old[prefix:len(old)-suffix], new[prefix:len(new)-suffix], prefix)...)
+
+	for i := range suffix {
+		lines = append(lines, Line{Kind: Equal, Old: len(old) - suffix + i + 1, New: len(new) - suffix + i + 1})
+	}
+
+	return lines
+}
+
+// myers diffs two lists of lines. For each number of edits d, it keeps the
+// furthest position reached on each diagonal k, then walks back the path.
+func myers(old, new []string, offset int) []Line {
+	n, m := len(old), len(new)
+	size := n + m
+	if size == 0 {
+		return nil
+	}
+
+	// v[size+k] is the furthest x reached on the diagonal k = x - y.
+	v := make([]int, 2*size+2)
+	var trace [][]int
+	for d := 0; d <= size; d++ {
+		if d > maxEdits {
+			return replaceAll(n, m, offset)
+		}
+		// Keep the diagonals -d..d, from before this step, to walk back the path.
+		trace = append(trace, slices.Clone(v[size-d:size+d+1]))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[size+k-1] < v[size+k+1]) {
+				x = v[size+k+1]
+			} else {
+				x = v[size+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && old[x] == new[y] {
+				x++
+				y++
+			}
+			v[size+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, n, m, offset)
+			}
+		}
+	}
+
+	return replaceAll(n, m, offset)
+}
+
+// backtrack walks back the path found by myers, from the end.
+func backtrack(trace [][]int, n, m, offset int) []Line {
+	var lines []Line
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+
+		prevK := k - 1
+		if k == -d || (k != d && v[d+k-1] < v[d+k+1]) {
+			prevK = k + 1
+		}
+		prevX := 0
+		if d > 0 {
+			prevX = v[d+prevK]
+		}
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			lines = append(lines, Line{Kind: Equal, Old: offset + x, New: offset + y})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				lines = append(lines, Line{Kind: Added, New: offset + y})
+			} else {
+				lines = append(lines, Line{Kind: Removed, Old: offset + x})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	slices.Reverse(lines)
+
+	return lines
+}
+
+// replaceAll is the diff of two completely different lists of lines.
+func replaceAll(n, m, offset int) []Line {
+	lines := make([]Line, 0, n+m)
+	for i := range n {
+		lines = append(lines, Line{Kind: Removed, Old: offset + i + 1})
+	}
+	for j := range m {
+		lines = append(lines, Line{Kind: Added, New: offset + j + 1})
+	}
+
+	return lines
+}
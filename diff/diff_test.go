@@ -0,0 +1,102 @@
+package diff
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		expected string
+	}{
+		{name: "empty"},
+		{name: "same", old: "a b c", new: "a b c", expected: "=1,1 =2,2 =3,3"},
+		{name: "added", old: "", new: "a b", expected: "+1 +2"},
+		{name: "removed", old: "a b", new: "", expected: "-1 -2"},
+		{name: "appended", old: "a b", new: "a b c", expected: "=1,1 =2,2 +3"},
+		{name: "prepended", old: "b c", new: "a b c", expected: "+1 =1,2 =2,3"},
+		{name: "changed", old: "a b c", new: "a x c", expected: "=1,1 -2 +2 =3,3"},
+		{name: "replaced", old: "a b", new: "c d", expected: "-1 -2 +1 +2"},
+		{name: "moved", old: "a b c d", new: "b c d a", expected: "-1 =2,1 =3,2 =4,3 +4"},
+		{name: "interleaved", old: "a b c d e", new: "a c x e y", expected: "=1,1 -2 =3,2 -4 +3 =5,4 +5"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			old, new := strings.Fields(test.old), strings.Fields(test.new)
+
+			lines := Lines(old, new)
+
+			if actual := format(lines); actual != test.expected {
+				t.Errorf("got %q, expected %q", actual, test.expected)
+			}
+			checkLines(t, lines, old, new)
+		})
+	}
+}
+
+func TestLinesTooManyEdits(t *testing.T) {
+	var old, new []string
+	for i := range maxEdits {
+		old = append(old, "old"+strconv.Itoa(i))
+		new = append(new, "new"+strconv.Itoa(i))
+	}
+	old = append(old, "common")
+	new = append(new, "common")
+
+	lines := Lines(old, new)
+
+	checkLines(t, lines, old, new)
+	if last := lines[len(lines)-1]; last.Kind != Equal {
+		t.Errorf("the common suffix is lost: %+v", last)
+	}
+}
+
+// format describes the lines of a diff: =old,new for common lines,
+// -old for removed lines and +new for added lines.
+func format(lines []Line) string {
+	var parts []string
+	for _, line := range lines {
+		switch line.Kind {
+		case Equal:
+			parts = append(parts, "="+strconv.Itoa(line.Old)+","+strconv.Itoa(line.New))
+		case Removed:
+			parts = append(parts, "-"+strconv.Itoa(line.Old))
+		case Added:
+			parts = append(parts, "+"+strconv.Itoa(line.New))
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// checkLines checks that a diff goes through both versions in order.
+func checkLines(t *testing.T, lines []Line, old, new []string) {
+	t.Helper()
+
+	var fromOld, fromNew []string
+	for _, line := range lines {
+		if line.Kind != Added {
+			if line.Old != len(fromOld)+1 {
+				t.Fatalf("old line %d is out of order", line.Old)
+			}
+			fromOld = append(fromOld, old[line.Old-1])
+		}
+		if line.Kind != Removed {
+			if line.New != len(fromNew)+1 {
+				t.Fatalf("new line %d is out of order", line.New)
+			}
+			fromNew = append(fromNew, new[line.New-1])
+		}
+		if line.Kind == Equal && old[line.Old-1] != new[line.New-1] {
+			t.Fatalf("lines %d and %d are not equal", line.Old, line.New)
+		}
+	}
+
+	if !slices.Equal(fromOld, old) || !slices.Equal(fromNew, new) {
+		t.Fatal("the diff doesn't cover both versions")
+	}
+}
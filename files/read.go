@@ -3,13 +3,19 @@ package files
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 var Root = "."
 
+// ErrOutsideRoot is returned for paths that lead out of the root folder.
+var ErrOutsideRoot = errors.New("is not in the deck")
+
 // Read reads a file in .demoit folder.
 func Read(path ...string) ([]byte, error) {
 	return os.ReadFile(fullpath(path...))
@@ -37,6 +43,44 @@ func Sha256(path ...string) (string, error) {
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
+// Path returns the path of a file relative to the root folder.
+// Absolute paths are returned unchanged.
+func Path(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return fullpath(path)
+}
+
+// InRoot returns the path of an existing file of the root folder, with the
+// symlinks resolved. Absolute paths, paths with .. and symlinks that lead
+// out of the root folder fail with ErrOutsideRoot.
+func InRoot(name string) (string, error) {
+	name = filepath.Clean(filepath.FromSlash(name))
+	if filepath.IsAbs(name) || isOutside(name) {
+		return "", fmt.Errorf("%s %w", name, ErrOutsideRoot)
+	}
+
+	root, err := filepath.EvalSymlinks(Root)
+	if err != nil {
+		return "", err
+	}
+	path, err := filepath.EvalSymlinks(fullpath(name))
+	if err != nil {
+		return "", err
+	}
+	if rel, err := filepath.Rel(root, path); err != nil || isOutside(rel) {
+		return "", fmt.Errorf("%s %w", name, ErrOutsideRoot)
+	}
+
+	return path, nil
+}
+
+func isOutside(rel string) bool {
+	return rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
 func fullpath(path ...string) string {
 	return filepath.Join(append([]string{Root}, path...)...)
 }
@@ -0,0 +1,65 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUncommittedChanges is returned when a working tree can't be checked
+// out because it has uncommitted changes.
+var ErrUncommittedChanges = errors.New("uncommitted changes")
+
+// What to do with the uncommitted changes of a working tree before a checkout.
+const (
+	// Refuse doesn't check out a working tree that has uncommitted changes.
+	Refuse = "refuse"
+	// Stash stashes the uncommitted changes, untracked files included.
+	Stash = "stash"
+	// Discard throws the uncommitted changes and the untracked files away.
+	Discard = "discard"
+)
+
+// Checkout resets a working tree to a revision, detaching its HEAD.
+// Nothing is done if the HEAD is already at that revision, so that the
+// changes made during a demo survive a reload of the slide. It returns
+// true if the working tree was checked out.
+func Checkout(dir, rev, uncommitted string) (bool, error) {
+	target, err := Resolve(dir, rev)
+	if err != nil {
+		return false, err
+	}
+
+	head, err := run(dir, "rev-parse", "--verify", "--quiet", "HEAD")
+	if err == nil && strings.TrimSpace(string(head)) == target {
+		return false, nil
+	}
+
+	status, err := run(dir, "status", "--porcelain")
+	if err != nil {
+		return false, fmt.Errorf("unable to get the status of %s: %w", dir, err)
+	}
+	if len(status) > 0 {
+		switch uncommitted {
+		case Stash:
+			if _, err := run(dir, "stash", "push", "--include-untracked", "--message", "demoit: before checking out "+rev); err != nil {
+				return false, fmt.Errorf("unable to stash the changes of %s: %w", dir, err)
+			}
+		case Discard:
+			if _, err := run(dir, "reset", "--hard", "--quiet"); err != nil {
+				return false, fmt.Errorf("unable to discard the changes of %s: %w", dir, err)
+			}
+			if _, err := run(dir, "clean", "-d", "--force", "--quiet"); err != nil {
+				return false, fmt.Errorf("unable to remove the untracked files of %s: %w", dir, err)
+			}
+		default:
+			return false, fmt.Errorf("%s has %w, commit or stash them before checking out %s", dir, ErrUncommittedChanges, rev)
+		}
+	}
+
+	if _, err := run(dir, "checkout", "--quiet", "--detach", target); err != nil {
+		return false, fmt.Errorf("unable to check out %s in %s: %w", rev, dir, err)
+	}
+
+	return true, nil
+}
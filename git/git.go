@@ -0,0 +1,82 @@
+// Package git reads files from the git repository of a deck and checks
+// out the demo working tree.
+package git
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	// ErrNotFound is returned when a file doesn't exist at a given revision.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidRevision is returned for revisions that can't be passed to git.
+	ErrInvalidRevision = errors.New("invalid revision")
+)
+
+// Show returns the content of a file at a revision: a commit, a tag or
+// a branch. The file is found in the repository that contains it.
+func Show(path, rev string) ([]byte, error) {
+	if err := checkRevision(rev); err != nil {
+		return nil, err
+	}
+
+	dir, name := filepath.Split(path)
+	if dir == "" {
+		dir = "."
+	}
+
+	content, err := run(dir, "show", rev+":./"+name)
+	if err != nil {
+		message := err.Error()
+		if strings.Contains(message, "does not exist") || strings.Contains(message, "exists on disk, but not in") || strings.Contains(message, "invalid object name") || strings.Contains(message, "unknown revision") {
+			return nil, fmt.Errorf("%s@%s: %w", path, rev, ErrNotFound)
+		}
+		return nil, fmt.Errorf("unable to read %s@%s: %s", path, rev, message)
+	}
+
+	return content, nil
+}
+
+// Resolve returns the commit a revision points to.
+func Resolve(dir, rev string) (string, error) {
+	if err := checkRevision(rev); err != nil {
+		return "", err
+	}
+
+	commit, err := run(dir, "rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", rev, ErrNotFound)
+	}
+
+	return strings.TrimSpace(string(commit)), nil
+}
+
+func checkRevision(rev string) error {
+	if rev == "" || strings.HasPrefix(rev, "-") || strings.ContainsAny(rev, ": \t\n") {
+		return fmt.Errorf("%w %q", ErrInvalidRevision, rev)
+	}
+
+	return nil
+}
+
+// run runs a git command in a folder and returns its output.
+// The error has the message printed by git.
+func run(dir string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, errors.New(message)
+		}
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -7,11 +7,13 @@ require (
 	github.com/gorilla/mux v1.8.1
 	github.com/gorilla/websocket v1.5.3
 	github.com/rjeczalik/notify v0.9.3
+	golang.org/x/crypto v0.57.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/dlclark/regexp2 v1.11.5 // indirect
-	golang.org/x/sys v0.2.0 // indirect
+	golang.org/x/sys v0.48.0 // indirect
 )
 
-go 1.26
+go 1.26.0
@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strconv"
@@ -11,27 +13,75 @@ import (
 	"github.com/alecthomas/chroma/v2/formatters/html"
 	"github.com/alecthomas/chroma/v2/lexers"
 	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/dgageot/demoit/codestyle"
+	"github.com/dgageot/demoit/config"
 	"github.com/dgageot/demoit/files"
+	"github.com/dgageot/demoit/git"
+	"github.com/dgageot/demoit/regions"
 )
 
-// Code returns the content of a source file.
+// Code returns the content of a source file, or its content
+// at a git revision (commit, tag or branch) with ?rev=.
+// With ?raw=true, the content isn't highlighted, for editing.
+// With ?fragment=true, it's only the highlighted code, with no stylesheet.
+// ?lang= overrides the language of the file.
 func Code(w http.ResponseWriter, r *http.Request) {
 	filename := strings.TrimPrefix(r.URL.Path, "/sourceCode/")
 
-	contents, err := files.Read(filename)
-	if errors.Is(err, os.ErrNotExist) {
+	contents, err := readRevision(filename, r.FormValue("rev"))
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, git.ErrNotFound) {
 		http.NotFound(w, r)
 		return
 	}
+	if errors.Is(err, git.ErrInvalidRevision) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		http.Error(w, "Unable to read "+filename, http.StatusInternalServerError)
 		return
 	}
 
-	lexer := lexer(filename)
+	// The ETag is what an edited file must match to be saved.
+	if r.FormValue("rev") == "" {
+		if sum, err := files.Sha256(filename); err == nil {
+			w.Header().Set("ETag", `"`+sum+`"`)
+		}
+		// Tell the code viewer to show a run button.
+		if _, found := config.Current.Code.RunCommand(filename); found {
+			w.Header().Set(runHeader, "true")
+		}
+	}
+	if raw, _ := strconv.ParseBool(r.FormValue("raw")); raw {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write(contents)
+		return
+	}
+
+	lexer, warning := lexer(filename, r.FormValue("lang"))
+	if warning != "" {
+		fmt.Println("Warning:", warning)
+		w.Header().Add(warningHeader, warning)
+	}
 	style := style(r.FormValue("style"))
-	lines := highligtedLines(r)
-	formatter := html.New(html.Standalone(true), html.WithLineNumbers(true), html.HighlightLines(lines), html.WithClasses(true))
+	lines, warnings := highligtedLines(r, filename, contents)
+	for _, warning := range warnings {
+		fmt.Println("Warning:", warning)
+		w.Header().Add(warningHeader, warning)
+	}
+	options := []html.Option{html.WithLineNumbers(true), html.HighlightLines(lines), html.WithClasses(true)}
+
+	var region *regions.Region
+	if spec := r.FormValue("region"); spec != "" {
+		found, err := regions.Parse(filename, contents, spec)
+		if err != nil {
+			warning := fmt.Sprintf("%s: region %s: %v", filename, spec, err)
+			fmt.Println("Warning:", warning)
+			w.Header().Add(warningHeader, warning)
+		} else {
+			region = &found
+		}
+	}
 
 	iterator, err := lexer.Tokenise(nil, string(contents))
 	if err != nil {
@@ -39,18 +89,98 @@ func Code(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A fragment has no stylesheet, it's served by /sourceCode.css.
+	fragment, _ := strconv.ParseBool(r.FormValue("fragment"))
+
 	w.Header().Set("Content-Type", "text/html")
-	if err := formatter.Format(w, style, iterator); err != nil {
+	if region == nil {
+		err = html.New(append(options, html.Standalone(!fragment))...).Format(w, style, iterator)
+	} else {
+		context, _ := strconv.Atoi(r.FormValue("context"))
+		fold, _ := strconv.ParseBool(r.FormValue("fold"))
+		err = formatRegion(w, options, style, iterator, *region, max(context, 0), fold, !fragment)
+	}
+	if err != nil {
 		http.Error(w, "Unable to format source code", http.StatusInternalServerError)
 		return
 	}
 }
 
-type nonDefaultYAMLLexer struct {
+// CodeCSS returns the stylesheet of the code viewer for a style, so that
+// the code can be fetched as fragments and the style changed without
+// fetching the code again.
+func CodeCSS(w http.ResponseWriter, r *http.Request) {
+	formatter := html.New(html.WithLineNumbers(true), html.WithClasses(true))
+
+	w.Header().Set("Content-Type", "text/css")
+	if err := formatter.WriteCSS(w, style(r.FormValue("style"))); err != nil {
+		http.Error(w, "Unable to write the stylesheet", http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprint(w, diffCSS)
+}
+
+// formatRegion renders only a region of a file, with context lines around it.
+// The whole file is tokenised so that multi-line tokens are right, and
+// the lines keep their numbers. With fold, a "…" line shows the elided parts.
+func formatRegion(w io.Writer, options []html.Option, style *chroma.Style, iterator chroma.Iterator, region regions.Region, context int, fold, standalone bool) error {
+	lines := chroma.SplitTokensIntoLines(iterator.Tokens())
+	start := max(region.Start-context, 1)
+	end := min(region.End+context, len(lines))
+
+	var tokens []chroma.Token
+	for _, line := range lines[start-1 : end] {
+		tokens = append(tokens, line...)
+	}
+
+	formatter := html.New(append(options, html.BaseLineNumber(start))...)
+	foldLine := func() {
+		if fold {
+			padding := strings.Repeat(" ", len(strconv.Itoa(end)))
+			fmt.Fprintf(w, `<pre class="chroma"><span class="line"><span class="ln">%s</span><span class="cl"><span class="c">…</span></span></span></pre>`+"\n", padding)
+		}
+	}
+
+	if standalone {
+		fmt.Fprint(w, "<html>\n<style type=\"text/css\">\n")
+		if err := formatter.WriteCSS(w, style); err != nil {
+			return err
+		}
+		fmt.Fprint(w, "</style><body class=\"bg\">\n")
+	}
+	if start > 1 {
+		foldLine()
+	}
+	if err := formatter.Format(w, style, chroma.Literator(tokens...)); err != nil {
+		return err
+	}
+	if end < len(lines) {
+		foldLine()
+	}
+	if standalone {
+		fmt.Fprint(w, "</body>\n</html>\n")
+	}
+
+	return nil
+}
+
+// readRevision reads a file in the working tree or, if rev is not empty,
+// in the git repository that contains it.
+func readRevision(filename, rev string) ([]byte, error) {
+	if rev == "" {
+		return files.Read(filename)
+	}
+
+	return git.Show(files.Path(filename), rev)
+}
+
+// stringValuesLexer colors the plain text values as strings, e.g. the
+// values of a YAML file, that chroma leaves as text.
+type stringValuesLexer struct {
 	chroma.Lexer
 }
 
-func (n *nonDefaultYAMLLexer) Tokenise(options *chroma.TokeniseOptions, text string) (chroma.Iterator, error) {
+func (n *stringValuesLexer) Tokenise(options *chroma.TokeniseOptions, text string) (chroma.Iterator, error) {
 	iterator, err := n.Lexer.Tokenise(nil, text)
 	if err != nil {
 		return nil, err
@@ -59,11 +189,12 @@ func (n *nonDefaultYAMLLexer) Tokenise(options *chroma.TokeniseOptions, text str
 	updated := iterator.Tokens()
 
 	for i, token := range updated {
-		if token.Type != chroma.Text {
+		// Recent lexers, like chroma's YAML lexer, emit the values as literals.
+		if token.Type != chroma.Text && token.Type != chroma.Literal {
 			continue
 		}
 
-		if token.Value == "-" {
+		if value := strings.TrimSpace(token.Value); value == "" || value == "-" {
 			continue
 		}
 
@@ -83,46 +214,120 @@ func (n *nonDefaultYAMLLexer) Tokenise(options *chroma.TokeniseOptions, text str
 	return chroma.Literator(updated...), nil
 }
 
-func lexer(file string) chroma.Lexer {
-	if strings.HasSuffix(file, ".yaml") || strings.HasSuffix(file, ".yml") {
-		return &nonDefaultYAMLLexer{lexers.Get(".yaml")}
+// lexer picks the lexer of a file: the requested language, the language
+// configured for the file or the one recognized from its name. It returns
+// a warning if the requested language is unknown.
+func lexer(file, lang string) (chroma.Lexer, string) {
+	var (
+		l       chroma.Lexer
+		warning string
+	)
+	if lang != "" {
+		if l = lexers.Get(lang); l == nil {
+			warning = fmt.Sprintf("%s: unknown language %q", file, lang)
+		}
 	}
+	if l == nil {
+		if configured, found := config.Current.Code.Language(file); found {
+			l = lexers.Get(configured)
+		}
+	}
+	if l == nil {
+		l = lexers.Match(file)
+	}
+	if l == nil {
+		l = lexers.Fallback
+	}
+
+	if hasStringValues(l) {
+		l = &stringValuesLexer{l}
+	}
+
+	return l, warning
+}
 
-	if l := lexers.Match(file); l != nil {
-		return l
+// hasStringValues tells if the plain text values of a language
+// should be colored as strings.
+func hasStringValues(l chroma.Lexer) bool {
+	for _, language := range config.Current.Code.StringValues {
+		if configured := lexers.Get(language); configured != nil && configured.Config().Name == l.Config().Name {
+			return true
+		}
 	}
 
-	return lexers.Fallback
+	return false
 }
 
 func style(name string) *chroma.Style {
 	if name != "" {
-		style := styles.Get(name)
-		if style != nil {
+		if style, found := codestyle.Get(name); found {
 			return style
 		}
 	}
 
-	return styles.Get("github")
+	if style, found := codestyle.Get(config.Current.Code.Style); found {
+		return style
+	}
+
+	return styles.Fallback
 }
 
-func highligtedLines(r *http.Request) [][2]int {
+// warningHeader has the problems found with the highlights, so that they
+// can be fixed before the talk instead of silently showing nothing.
+const warningHeader = "Demoit-Warning"
+
+// runHeader tells if there's a command to run a file.
+const runHeader = "Demoit-Run"
+
+// highligtedLines returns the ranges of lines to highlight: numeric
+// startLine/endLine pairs and named regions, either markers or symbols.
+// Ranges out of the file are dropped with a warning.
+func highligtedLines(r *http.Request, filename string, contents []byte) ([][2]int, []string) {
+	var (
+		lines    [][2]int
+		warnings []string
+	)
+
 	startParam := r.FormValue("startLine")
 	endParam := r.FormValue("endLine")
-	if startParam == "" || endParam == "" {
-		return nil
+	if startParam != "" && endParam != "" {
+		startLines := strings.Split(startParam, ",")
+		endLines := strings.Split(endParam, ",")
+		if len(startLines) != len(endLines) {
+			warnings = append(warnings, fmt.Sprintf("%s: %d start lines but %d end lines", filename, len(startLines), len(endLines)))
+		}
+
+		for i := range min(len(startLines), len(endLines)) {
+			startLine, _ := strconv.Atoi(startLines[i])
+			endLine, _ := strconv.Atoi(endLines[i])
+
+			lines = append(lines, [2]int{startLine, endLine})
+		}
 	}
 
-	startLines := strings.Split(startParam, ",")
-	endLines := strings.Split(endParam, ",")
+	for name := range strings.SplitSeq(r.FormValue("highlight"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
 
-	lines := make([][2]int, 0, len(startLines))
-	for i := range startLines {
-		startLine, _ := strconv.Atoi(startLines[i])
-		endLine, _ := strconv.Atoi(endLines[i])
+		region, err := regions.Find(filename, contents, name)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %v", filename, err))
+			continue
+		}
+		lines = append(lines, [2]int{region.Start, region.End})
+	}
 
-		lines = append(lines, [2]int{startLine, endLine})
+	lineCount := regions.LineCount(contents)
+	inRange := lines[:0]
+	for _, line := range lines {
+		if line[0] < 1 || line[1] < line[0] || line[1] > lineCount {
+			warnings = append(warnings, fmt.Sprintf("%s: range %d-%d is out of the file's %d lines", filename, line[0], line[1], lineCount))
+			continue
+		}
+		inRange = append(inRange, line)
 	}
 
-	return lines
+	return inRange, warnings
 }
@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/dgageot/demoit/diff"
+	"github.com/dgageot/demoit/files"
+	"github.com/dgageot/demoit/git"
+)
+
+//go:embed resources/diff.tmpl.html
+var diffHTML string
+
+var diffTemplate = template.Must(template.New("diff").Parse(diffHTML))
+
+// diffCSS styles the diffs, with the classes of a chroma stylesheet.
+const diffCSS = `
+table.diff { border-collapse: collapse; width: 100%; }
+table.diff td { padding: 0 0.5em; white-space: pre; vertical-align: top; }
+table.diff td.ln { text-align: right; user-select: none; opacity: 0.6; }
+table.diff td.marker { user-select: none; width: 1em; }
+table.diff td.split { width: 50%; }
+table.diff .added { background-color: rgba(46, 160, 67, 0.2); }
+table.diff .removed { background-color: rgba(248, 81, 73, 0.2); }
+`
+
+type diffPage struct {
+	CSS      template.CSS
+	Fragment bool
+	Split    bool
+	Rows     []diffRow
+}
+
+// diffRow is a row of the diff. In the unified view, Kind tells which
+// side is shown. In the split view, each side has its own kind.
+type diffRow struct {
+	Kind string
+	Old  diffCell
+	New  diffCell
+}
+
+type diffCell struct {
+	Line int
+	Kind string
+	Code template.HTML
+}
+
+// Diff shows the changes made to a source file, compared to another file
+// (?against=) or to the same file at a git revision (?rev=).
+// The view is unified by default, or side by side with ?view=split.
+// With ?fragment=true, there's no stylesheet, it's served by /sourceCode.css.
+func Diff(w http.ResponseWriter, r *http.Request) {
+	filename := strings.TrimPrefix(r.URL.Path, "/diff/")
+	against := r.FormValue("against")
+	rev := r.FormValue("rev")
+	if against == "" && rev == "" {
+		http.Error(w, "Missing against or rev parameter", http.StatusBadRequest)
+		return
+	}
+
+	// Both files must be in the deck. Missing files are reported below.
+	for _, name := range []string{filename, against} {
+		if name == "" {
+			continue
+		}
+		if _, err := files.InRoot(name); errors.Is(err, files.ErrOutsideRoot) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+
+	newContents, err := files.Read(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Unable to read "+filename, http.StatusInternalServerError)
+		return
+	}
+
+	oldFilename := filename
+	if against != "" {
+		oldFilename = against
+	}
+
+	oldContents, err := readRevision(oldFilename, rev)
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, git.ErrNotFound) {
+		http.NotFound(w, r)
+		return
+	}
+	if errors.Is(err, git.ErrInvalidRevision) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Unable to read "+oldFilename+": "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	lexer, warning := lexer(filename, r.FormValue("lang"))
+	if warning != "" {
+		fmt.Println("Warning:", warning)
+		w.Header().Add(warningHeader, warning)
+	}
+	oldLines, err := highlightLines(lexer, string(oldContents))
+	if err != nil {
+		http.Error(w, "Unable to tokenize "+oldFilename, http.StatusInternalServerError)
+		return
+	}
+	newLines, err := highlightLines(lexer, string(newContents))
+	if err != nil {
+		http.Error(w, "Unable to tokenize "+filename, http.StatusInternalServerError)
+		return
+	}
+
+	var css bytes.Buffer
+	if err := html.New(html.WithClasses(true)).WriteCSS(&css, style(r.FormValue("style"))); err != nil {
+		http.Error(w, "Unable to format source code", http.StatusInternalServerError)
+		return
+	}
+	fragment, _ := strconv.ParseBool(r.FormValue("fragment"))
+
+	page := diffPage{
+		CSS:      template.CSS(css.String() + diffCSS),
+		Fragment: fragment,
+		Split:    r.FormValue("view") == "split",
+	}
+	changes := diff.Lines(splitLines(string(oldContents)), splitLines(string(newContents)))
+	if page.Split {
+		page.Rows = splitRows(changes, oldLines, newLines)
+	} else {
+		page.Rows = unifiedRows(changes, oldLines, newLines)
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	if err := diffTemplate.Execute(w, page); err != nil {
+		http.Error(w, "Unable to format source code", http.StatusInternalServerError)
+		return
+	}
+}
+
+func unifiedRows(changes []diff.Line, oldLines, newLines []template.HTML) []diffRow {
+	rows := make([]diffRow, 0, len(changes))
+	for _, change := range changes {
+		rows = append(rows, diffRow{
+			Kind: kindName(change.Kind),
+			Old:  cell(change.Old, "", oldLines),
+			New:  cell(change.New, "", newLines),
+		})
+	}
+
+	return rows
+}
+
+// splitRows pairs the removed lines with the added lines that follow them,
+// so that a changed line shows on a single row.
+func splitRows(changes []diff.Line, oldLines, newLines []template.HTML) []diffRow {
+	var rows []diffRow
+	for i := 0; i < len(changes); {
+		if changes[i].Kind == diff.Equal {
+			rows = append(rows, diffRow{
+				Kind: "equal",
+				Old:  cell(changes[i].Old, "", oldLines),
+				New:  cell(changes[i].New, "", newLines),
+			})
+			i++
+			continue
+		}
+
+		var removed, added []int
+		for ; i < len(changes) && changes[i].Kind != diff.Equal; i++ {
+			if changes[i].Kind == diff.Removed {
+				removed = append(removed, changes[i].Old)
+			} else {
+				added = append(added, changes[i].New)
+			}
+		}
+		for j := range max(len(removed), len(added)) {
+			var row diffRow
+			if j < len(removed) {
+				row.Old = cell(removed[j], "removed", oldLines)
+			}
+			if j < len(added) {
+				row.New = cell(added[j], "added", newLines)
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
+
+func cell(line int, kind string, lines []template.HTML) diffCell {
+	if line == 0 {
+		return diffCell{}
+	}
+
+	return diffCell{Line: line, Kind: kind, Code: lines[line-1]}
+}
+
+func kindName(kind diff.Kind) string {
+	switch kind {
+	case diff.Added:
+		return "added"
+	case diff.Removed:
+		return "removed"
+	default:
+		return "equal"
+	}
+}
+
+// splitLines splits a file into lines, without the line endings.
+func splitLines(contents string) []string {
+	contents = strings.TrimSuffix(contents, "\n")
+	if contents == "" {
+		return nil
+	}
+
+	lines := strings.Split(contents, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+
+	return lines
+}
+
+// highlightLines tokenises a whole file, so that multi-line tokens are
+// right, then renders each line with the css classes of the formatter.
+func highlightLines(lexer chroma.Lexer, contents string) ([]template.HTML, error) {
+	iterator, err := lexer.Tokenise(nil, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []template.HTML
+	for _, tokens := range chroma.SplitTokensIntoLines(iterator.Tokens()) {
+		var line strings.Builder
+		for _, token := range tokens {
+			value := strings.TrimRight(token.Value, "\r\n")
+			if value == "" {
+				continue
+			}
+
+			class := tokenClass(token.Type)
+			if class == "" {
+				line.WriteString(template.HTMLEscapeString(value))
+				continue
+			}
+			line.WriteString(`<span class="` + class + `">` + template.HTMLEscapeString(value) + `</span>`)
+		}
+		lines = append(lines, template.HTML(line.String()))
+	}
+
+	// Keep the lines in sync with splitLines.
+	for len(lines) < len(splitLines(contents)) {
+		lines = append(lines, "")
+	}
+
+	return lines, nil
+}
+
+// tokenClass is the css class the html formatter uses for a token type.
+func tokenClass(tokenType chroma.TokenType) string {
+	for _, t := range []chroma.TokenType{tokenType, tokenType.SubCategory(), tokenType.Category()} {
+		if class, found := chroma.StandardTypes[t]; found {
+			return class
+		}
+	}
+
+	return ""
+}
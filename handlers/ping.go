@@ -1,23 +1,146 @@
 package handlers
 
-import "net/http"
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
-// Ping does http HEAD on a URL and returns its status.
-func Ping(w http.ResponseWriter, r *http.Request) {
-	pingURL := r.FormValue("url")
+	"github.com/dgageot/demoit/config"
+	"github.com/dgageot/demoit/deck"
+	"github.com/dgageot/demoit/files"
+)
+
+const (
+	pingTimeout      = 2 * time.Second
+	pingMaxRedirects = 3
+)
 
-	req, err := http.NewRequestWithContext(r.Context(), http.MethodHead, pingURL, http.NoBody)
+// Ping probes a URL and returns its status.
+// Only the urls used by <web-browser> components, the proxy targets
+// and the ones explicitly allowed in the configuration can be pinged.
+// Proxied urls are pinged on their target.
+//
+// Optional parameters refine what "ready" means: status is the expected
+// status code (e.g. 200 or 2xx), match is a regexp the body should match,
+// tcp=true only checks that the port accepts connections and timeout is
+// how long to wait for readiness. When the client accepts
+// text/event-stream, readiness is streamed as server-sent events.
+func Ping(w http.ResponseWriter, r *http.Request) {
+	p, err := parseProbe(r)
 	if err != nil {
-		http.Error(w, "Unable to ping", http.StatusBadRequest)
+		http.Error(w, "Unable to ping: "+err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	allowed, err := pingAllowlist()
 	if err != nil {
-		w.WriteHeader(http.StatusBadGateway)
+		http.Error(w, "Unable to read steps", http.StatusInternalServerError)
+		return
+	}
+	if !allowed.contains(p.url) {
+		http.Error(w, "Not allowed to ping "+p.url.Redacted(), http.StatusForbidden)
+		return
+	}
+
+	if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		streamReadiness(w, r, p, allowed)
 		return
 	}
-	defer resp.Body.Close()
 
-	w.WriteHeader(resp.StatusCode)
+	result := p.check(r.Context(), allowed)
+	switch {
+	case result.Ready && p.tcp:
+		w.WriteHeader(http.StatusOK)
+	case result.Status == 0:
+		w.WriteHeader(http.StatusBadGateway)
+	case !result.Ready && p.hasExpectations():
+		w.WriteHeader(http.StatusServiceUnavailable)
+	default:
+		w.WriteHeader(result.Status)
+	}
+}
+
+// pingClient returns an http client with a short timeout that
+// only follows a few redirects to allowed urls.
+func pingClient(allowed allowlist) *http.Client {
+	return &http.Client{
+		Timeout: pingTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > pingMaxRedirects {
+				return errors.New("too many redirects")
+			}
+			if !allowed.contains(req.URL) {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+}
+
+// allowlist is a list of urls or hosts that can be pinged.
+type allowlist []*url.URL
+
+// pingAllowlist lists the urls of the <web-browser> components, the
+// proxy targets and the extra hosts allowed in the configuration.
+func pingAllowlist() (allowlist, error) {
+	steps, err := readSteps(files.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	var allowed allowlist
+	for _, step := range steps {
+		for _, browser := range deck.Elements([]byte(step.HTML), "web-browser") {
+			if u, err := url.Parse(browser.Attrs["src"]); err == nil && u.Host != "" {
+				allowed = append(allowed, u)
+			}
+		}
+	}
+
+	for name := range config.Current.Proxy {
+		if target, err := config.Current.ProxyTarget(name); err == nil {
+			allowed = append(allowed, target)
+		}
+	}
+
+	for _, entry := range config.Current.Security.PingAllowlist {
+		if u, err := config.ParseHost(entry); err == nil && u.Host != "" {
+			allowed = append(allowed, u)
+		}
+	}
+
+	return allowed, nil
+}
+
+// contains tests if a url targets the same scheme, host and port as
+// an entry of the list. Urls have an implicit port, from their scheme.
+// Only the hosts without a scheme or a port, from the configuration,
+// match any scheme and any port.
+func (a allowlist) contains(u *url.URL) bool {
+	for _, entry := range a {
+		if entry.Scheme != "" && entry.Scheme != u.Scheme {
+			continue
+		}
+		if !strings.EqualFold(entry.Hostname(), u.Hostname()) {
+			continue
+		}
+		if (entry.Scheme != "" || entry.Port() != "") && portOf(entry) != portOf(u) {
+			continue
+		}
+		return true
+	}
+
+	return false
+}
+
+func portOf(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+	if u.Scheme == "https" {
+		return "443"
+	}
+	return "80"
 }
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dgageot/demoit/config"
+	"github.com/dgageot/demoit/deck"
+	"github.com/dgageot/demoit/files"
+)
+
+func TestAllowlistContains(t *testing.T) {
+	tests := []struct {
+		entry, url string
+		expected   bool
+	}{
+		{"http://127.0.0.1/", "http://127.0.0.1/", true},
+		{"http://127.0.0.1/", "http://127.0.0.1:80/health", true},
+		{"http://127.0.0.1/", "http://127.0.0.1:22/", false},
+		{"http://127.0.0.1/", "https://127.0.0.1/", false},
+		{"https://127.0.0.1/", "https://127.0.0.1:443/", true},
+		{"http://127.0.0.1:8080/", "http://127.0.0.1:8080/", true},
+		{"http://127.0.0.1:8080/", "http://127.0.0.1:8081/", false},
+		{"127.0.0.1", "http://127.0.0.1:22/", true},
+		{"127.0.0.1:8080", "https://127.0.0.1:8080/", true},
+		{"127.0.0.1:8080", "http://127.0.0.1:8081/", false},
+		{"127.0.0.1", "http://localhost/", false},
+	}
+	for _, test := range tests {
+		entry, err := config.ParseHost(test.entry)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := url.Parse(test.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if actual := (allowlist{entry}).contains(u); actual != test.expected {
+			t.Errorf("%s contains %s: got %t, expected %t", test.entry, test.url, actual, test.expected)
+		}
+	}
+}
+
+func TestPingOtherPort(t *testing.T) {
+	useDeck(t, `<web-browser src="http://127.0.0.1/"></web-browser>`)
+
+	for _, target := range []string{"http://127.0.0.1:22/", "http://127.0.0.1:18888/"} {
+		request := httptest.NewRequest(http.MethodGet, "/ping?tcp=true&url="+url.QueryEscape(target), nil)
+		response := httptest.NewRecorder()
+
+		Ping(response, request)
+
+		if response.Code != http.StatusForbidden {
+			t.Errorf("ping %s: got %d, expected %d", target, response.Code, http.StatusForbidden)
+		}
+	}
+}
+
+// useDeck presents, for the duration of a test, a deck with the given
+// slides and the default configuration, in dev mode so that the slides
+// are not cached.
+func useDeck(t *testing.T, slides string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, deck.FileName), []byte(slides), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	previousRoot, previousConfig := files.Root, config.Current
+	t.Cleanup(func() { files.Root, config.Current = previousRoot, previousConfig })
+
+	files.Root = root
+	config.Current = config.Default()
+	config.Current.Dev = true
+
+	return root
+}
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgageot/demoit/config"
+)
+
+const (
+	probeInterval       = 500 * time.Millisecond
+	probeDefaultTimeout = 5 * time.Minute
+	probeMaxTimeout     = 10 * time.Minute
+	probeMaxBodySize    = 1 << 20
+)
+
+// probe describes what makes a url ready.
+type probe struct {
+	url     *url.URL
+	status  string
+	match   *regexp.Regexp
+	tcp     bool
+	timeout time.Duration
+}
+
+// probeResult is the outcome of a single probe.
+type probeResult struct {
+	Ready  bool   `json:"ready"`
+	Status int    `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+var statusRegexp = regexp.MustCompile(`^[1-5]([0-9]{2}|xx)$`)
+
+func parseProbe(r *http.Request) (*probe, error) {
+	u, err := url.Parse(r.FormValue("url"))
+	if err != nil {
+		return nil, err
+	}
+	u = config.Current.Unproxied(u)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, errors.New("only http and https urls can be pinged")
+	}
+
+	p := &probe{
+		url:     u,
+		status:  strings.ToLower(r.FormValue("status")),
+		timeout: probeDefaultTimeout,
+	}
+
+	if p.status != "" && !statusRegexp.MatchString(p.status) {
+		return nil, fmt.Errorf("invalid status %q", p.status)
+	}
+
+	if match := r.FormValue("match"); match != "" {
+		if p.match, err = regexp.Compile(match); err != nil {
+			return nil, fmt.Errorf("invalid match: %w", err)
+		}
+	}
+
+	if tcp := r.FormValue("tcp"); tcp != "" {
+		if p.tcp, err = strconv.ParseBool(tcp); err != nil {
+			return nil, fmt.Errorf("invalid tcp: %w", err)
+		}
+	}
+
+	if timeout := r.FormValue("timeout"); timeout != "" {
+		if p.timeout, err = time.ParseDuration(timeout); err != nil {
+			return nil, fmt.Errorf("invalid timeout: %w", err)
+		}
+		if p.timeout < 0 {
+			return nil, fmt.Errorf("invalid timeout %q: should not be negative", timeout)
+		}
+		// Don't keep probing for as long as a client stays connected.
+		p.timeout = min(p.timeout, probeMaxTimeout)
+	}
+
+	return p, nil
+}
+
+func (p *probe) hasExpectations() bool {
+	return p.status != "" || p.match != nil
+}
+
+// check probes the url once.
+func (p *probe) check(ctx context.Context, allowed allowlist) probeResult {
+	if p.tcp {
+		return p.checkTCP(ctx)
+	}
+
+	method := http.MethodHead
+	if p.match != nil {
+		method = http.MethodGet
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.url.String(), http.NoBody)
+	if err != nil {
+		return probeResult{Error: err.Error()}
+	}
+
+	resp, err := pingClient(allowed).Do(req)
+	if err != nil {
+		return probeResult{Error: err.Error()}
+	}
+	defer resp.Body.Close()
+
+	result := probeResult{Status: resp.StatusCode, Ready: p.expectedStatus(resp.StatusCode)}
+	if result.Ready && p.match != nil {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, probeMaxBodySize))
+		if err != nil {
+			return probeResult{Status: resp.StatusCode, Error: err.Error()}
+		}
+		result.Ready = p.match.Match(body)
+	}
+
+	return result
+}
+
+func (p *probe) checkTCP(ctx context.Context) probeResult {
+	dialer := net.Dialer{Timeout: pingTimeout}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(p.url.Hostname(), portOf(p.url)))
+	if err != nil {
+		return probeResult{Error: err.Error()}
+	}
+	_ = conn.Close()
+
+	return probeResult{Ready: true}
+}
+
+// expectedStatus tests a status code against the expected status.
+// Without expectation, any status below 400 is fine.
+func (p *probe) expectedStatus(status int) bool {
+	if p.status == "" {
+		return status < http.StatusBadRequest
+	}
+
+	code := strconv.Itoa(status)
+	for i := range len(p.status) {
+		if p.status[i] != 'x' && p.status[i] != code[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// streamReadiness probes a url until it's ready or the timeout expires.
+// Every change is sent as a "status" server-sent event. The stream ends
+// with either a "ready" or a "timeout" event.
+func streamReadiness(w http.ResponseWriter, r *http.Request, p *probe, allowed allowlist) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	rc := http.NewResponseController(w)
+
+	ctx, cancel := context.WithTimeout(r.Context(), p.timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+
+	var previous *probeResult
+	for {
+		result := p.check(ctx, allowed)
+		if ctx.Err() != nil {
+			break
+		}
+
+		if previous == nil || result.Status != previous.Status || (result.Error == "") != (previous.Error == "") {
+			if err := writeEvent(w, rc, "status", result); err != nil {
+				return
+			}
+			previous = &result
+		}
+
+		if result.Ready {
+			_ = writeEvent(w, rc, "ready", result)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+	}
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		last := probeResult{Error: "timeout"}
+		if previous != nil {
+			last = *previous
+		}
+		_ = writeEvent(w, rc, "timeout", last)
+	}
+}
+
+func writeEvent(w io.Writer, rc *http.ResponseController, event string, result probeResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+
+	return rc.Flush()
+}
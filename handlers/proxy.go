@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"github.com/dgageot/demoit/config"
+	"github.com/gorilla/mux"
+)
+
+// Proxy forwards requests made under /proxy/{name}/ to a configured local target.
+// Websockets are passed through.
+func Proxy(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["name"]
+
+	target, err := config.Current.ProxyTarget(name)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	prefix := proxyPrefix(name)
+	if r.URL.Path == strings.TrimSuffix(prefix, "/") {
+		http.Redirect(w, r, prefix, http.StatusMovedPermanently)
+		return
+	}
+
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.Out.URL.Path = strings.TrimPrefix(pr.In.URL.Path, strings.TrimSuffix(prefix, "/"))
+			pr.Out.URL.RawPath = ""
+			pr.SetURL(target)
+			pr.SetXForwarded()
+		},
+		ModifyResponse: func(resp *http.Response) error {
+			for _, header := range config.Current.Proxy[name].StripHeaders {
+				resp.Header.Del(header)
+			}
+
+			// Keep redirects to the target inside the proxy.
+			if location := resp.Header.Get("Location"); location != "" {
+				resp.Header.Set("Location", proxiedLocation(location, target, prefix))
+			}
+
+			return nil
+		},
+	}
+
+	proxy.ServeHTTP(w, r)
+}
+
+// proxiedLocation rewrites a redirect location that points to the target
+// so that it points to the proxy instead.
+func proxiedLocation(location string, target *url.URL, prefix string) string {
+	if rest, found := strings.CutPrefix(location, target.String()); found {
+		return prefix + rest
+	}
+
+	if strings.HasPrefix(location, "/") && !strings.HasPrefix(location, "//") {
+		if rest, found := strings.CutPrefix(location, target.Path); found {
+			return prefix + rest
+		}
+	}
+
+	return location
+}
+
+// proxyPrefix returns the path under which a target is proxied.
+func proxyPrefix(name string) string {
+	return "/proxy/" + name + "/"
+}
+
+// proxyTargets maps the local urls to the path they are proxied under.
+func proxyTargets() map[string]string {
+	targets := map[string]string{}
+	for name := range config.Current.Proxy {
+		if target, err := config.Current.ProxyTarget(name); err == nil {
+			targets[target.String()] = proxyPrefix(name)
+		}
+	}
+
+	return targets
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/dgageot/demoit/config"
+	"github.com/dgageot/demoit/deck"
+	"github.com/dgageot/demoit/files"
+)
+
+// maxSourceSize is the size limit of a saved source file.
+const maxSourceSize = 10 << 20
+
+var (
+	errForbiddenPath = errors.New("can't be edited")
+	saveLock         sync.Mutex
+)
+
+// SaveCode saves a source file edited in the code viewer. The If-Match
+// header must have the sha256 of the file, as sent in the ETag of /sourceCode/,
+// so that changes made meanwhile, in an editor or another viewer, are not lost.
+// Only the files of an editable <source-code> can be saved.
+// In dev mode, saving triggers a livereload event, like any file change.
+func SaveCode(w http.ResponseWriter, r *http.Request) {
+	filename := strings.TrimPrefix(r.URL.Path, "/sourceCode/")
+
+	editable, err := editableFiles()
+	if err != nil {
+		http.Error(w, "Unable to read the slides", http.StatusInternalServerError)
+		return
+	}
+	if !editable[path.Clean(filename)] {
+		http.Error(w, filename+" is not in an editable <source-code> and "+errForbiddenPath.Error(), http.StatusForbidden)
+		return
+	}
+
+	path, err := editablePath(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	expected := strings.Trim(strings.TrimPrefix(r.Header.Get("If-Match"), "W/"), `"`)
+	if expected == "" {
+		http.Error(w, "Missing If-Match header", http.StatusPreconditionRequired)
+		return
+	}
+
+	contents, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSourceSize))
+	if err != nil {
+		http.Error(w, "Unable to read the request", http.StatusBadRequest)
+		return
+	}
+
+	saveLock.Lock()
+	defer saveLock.Unlock()
+
+	current, err := files.Sha256(filename)
+	if err != nil {
+		http.Error(w, "Unable to read "+filename, http.StatusInternalServerError)
+		return
+	}
+	if current != expected {
+		http.Error(w, filename+" was changed meanwhile, reload it before saving", http.StatusPreconditionFailed)
+		return
+	}
+
+	// The file exists so its permissions are kept.
+	if err := os.WriteFile(path, contents, 0o644); err != nil {
+		http.Error(w, "Unable to write "+filename, http.StatusInternalServerError)
+		return
+	}
+	fmt.Println("Saved", filename)
+
+	if sum, err := files.Sha256(filename); err == nil {
+		w.Header().Set("ETag", `"`+sum+`"`)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// editableFiles lists the files of the <source-code> components that have
+// the editable attribute, relative to the deck.
+func editableFiles() (map[string]bool, error) {
+	steps, err := readSteps(files.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	editable := map[string]bool{}
+	for _, step := range steps {
+		for _, code := range deck.Elements([]byte(step.HTML), "source-code") {
+			if _, found := code.Attrs["editable"]; !found {
+				continue
+			}
+			for _, file := range strings.Fields(code.Attrs["files"]) {
+				// A tab can show a revision, file@rev, but saves the file.
+				if at := strings.LastIndex(file, "@"); at > 0 {
+					file = file[:at]
+				}
+				editable[path.Join(code.Attrs["folder"], file)] = true
+			}
+		}
+	}
+
+	return editable, nil
+}
+
+// editablePath returns the path of a source file that can be saved: an
+// existing regular file of the deck, symlinks included, but not the slides,
+// the configuration or the files in .demoit and .git.
+func editablePath(filename string) (string, error) {
+	name := filepath.Clean(filepath.FromSlash(filename))
+	if name == deck.FileName || name == config.FileName || slices.ContainsFunc(strings.Split(name, string(filepath.Separator)), func(part string) bool {
+		return part == ".demoit" || part == ".git"
+	}) {
+		return "", fmt.Errorf("%s %w", filename, errForbiddenPath)
+	}
+
+	path, err := files.InRoot(name)
+	if errors.Is(err, files.ErrOutsideRoot) {
+		return "", fmt.Errorf("%s is not in the deck and %w", filename, errForbiddenPath)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if !info.Mode().IsRegular() {
+		return "", fmt.Errorf("%s is not a file and %w", filename, errForbiddenPath)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dgageot/demoit/files"
+)
+
+func TestSaveCode(t *testing.T) {
+	root := useDeck(t, `<source-code folder="sources" files="editable.go" editable></source-code>
+<source-code folder="sources" files="readonly.go"></source-code>`)
+	if err := os.MkdirAll(filepath.Join(root, "sources"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		file     string
+		expected int
+	}{
+		{file: "sources/editable.go", expected: http.StatusNoContent},
+		{file: "sources/readonly.go", expected: http.StatusForbidden},
+		{file: "sources/../sources/readonly.go", expected: http.StatusForbidden},
+	}
+	for _, test := range tests {
+		path := filepath.Join(root, filepath.FromSlash(test.file))
+		if err := os.WriteFile(path, []byte("package sources\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		sum, err := files.Sha256(test.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		request := httptest.NewRequest(http.MethodPut, "/sourceCode/"+test.file, strings.NewReader("package changed\n"))
+		request.Header.Set("If-Match", `"`+sum+`"`)
+		response := httptest.NewRecorder()
+
+		SaveCode(response, request)
+
+		if response.Code != test.expected {
+			t.Errorf("save %s: got %d, expected %d", test.file, response.Code, test.expected)
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if saved := string(content) == "package changed\n"; saved != (test.expected == http.StatusNoContent) {
+			t.Errorf("save %s: the file was saved: %t", test.file, saved)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package handlers
 
 import (
-	"bytes"
 	_ "embed"
 	"errors"
 	"fmt"
@@ -12,8 +11,10 @@ import (
 	"strconv"
 	"sync"
 
+	"github.com/dgageot/demoit/config"
+	"github.com/dgageot/demoit/deck"
 	"github.com/dgageot/demoit/files"
-	"github.com/dgageot/demoit/flags"
+	"github.com/dgageot/demoit/git"
 	"github.com/gorilla/mux"
 )
 
@@ -23,13 +24,16 @@ var indexTemplate = template.Must(template.New("index").Funcs(template.FuncMap{"
 
 // Page describes a page of the demo.
 type Page struct {
-	HTML        template.HTML
-	URL         string
-	PrevURL     string
-	NextURL     string
-	CurrentStep int
-	StepCount   int
-	DevMode     bool
+	HTML         template.HTML
+	URL          string
+	PrevURL      string
+	NextURL      string
+	CurrentStep  int
+	StepCount    int
+	DevMode      bool
+	ProxyTargets map[string]string
+	// GitRef is the ref the demo working tree is reset to, if any.
+	GitRef string
 }
 
 // Step renders a given page.
@@ -51,6 +55,18 @@ func Step(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if ref := steps[id].GitRef; ref != "" {
+		err := checkoutStep(ref)
+		if errors.Is(err, git.ErrUncommittedChanges) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Unable to check out %s: %v", ref, err), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	w.Header().Set("Content-Type", "text/html")
 	if err := indexTemplate.Execute(w, steps[id]); err != nil {
 		http.Error(w, "Unable to render page", http.StatusInternalServerError)
@@ -58,6 +74,28 @@ func Step(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+var checkoutLock sync.Mutex
+
+// checkoutStep resets the demo working tree to the git ref of a slide,
+// before the slide is rendered, so that its terminals and code viewers
+// show the expected state.
+func checkoutStep(ref string) error {
+	worktree := config.Current.Git.Worktree
+	if worktree == "" {
+		return fmt.Errorf("git.worktree should be configured in %s", config.FileName)
+	}
+
+	checkoutLock.Lock()
+	defer checkoutLock.Unlock()
+
+	checkedOut, err := git.Checkout(files.Path(worktree), ref, config.Current.Git.Uncommitted)
+	if checkedOut {
+		fmt.Println("Checked out", ref, "in", worktree)
+	}
+
+	return err
+}
+
 // LastStep redirects to the latest page.
 func LastStep(w http.ResponseWriter, r *http.Request) {
 	steps, err := readSteps(files.Root)
@@ -76,7 +114,7 @@ var (
 )
 
 func readSteps(folder string) ([]Page, error) {
-	if !*flags.DevMode {
+	if !config.Current.Dev {
 		cacheStepsOnce.Do(func() {
 			cachedSteps, errCachedSteps = parseSteps(folder)
 		})
@@ -86,25 +124,28 @@ func readSteps(folder string) ([]Page, error) {
 }
 
 func parseSteps(folder string) ([]Page, error) {
-	content, err := os.ReadFile(filepath.Join(folder, "demoit.html"))
+	slides, err := deck.Read(folder)
 	if err != nil {
 		return nil, err
 	}
 
-	parts := bytes.Split(content, []byte("---"))
-	steps := make([]Page, len(parts))
-	for i, part := range parts {
+	steps := make([]Page, len(slides))
+	for i, slide := range slides {
 		url := "/"
 		if i > 0 {
 			url = fmt.Sprintf("/%d", i)
 		}
 
 		steps[i] = Page{
-			HTML:        template.HTML(part),
-			DevMode:     *flags.DevMode,
-			CurrentStep: i,
-			URL:         url,
-			StepCount:   len(parts) - 1,
+			HTML:         template.HTML(slide.Content),
+			DevMode:      config.Current.Dev,
+			CurrentStep:  i,
+			URL:          url,
+			StepCount:    len(slides) - 1,
+			ProxyTargets: proxyTargets(),
+		}
+		if checkouts := slide.Elements("git-checkout"); len(checkouts) > 0 {
+			steps[i].GitRef = checkouts[0].Attrs["ref"]
 		}
 	}
 
@@ -122,6 +163,10 @@ func parseSteps(folder string) ([]Page, error) {
 
 // VerifyConfiguration runs a couple of verifications on the configuration.
 func VerifyConfiguration() error {
+	if err := config.Current.Validate(); err != nil {
+		return err
+	}
+
 	if _, err := readSteps(files.Root); err != nil {
 		return err
 	}
@@ -1,40 +1,90 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
 
+	"github.com/dgageot/demoit/check"
+	"github.com/dgageot/demoit/codestyle"
+	"github.com/dgageot/demoit/config"
 	"github.com/dgageot/demoit/files"
-	"github.com/dgageot/demoit/flags"
 	"github.com/dgageot/demoit/handlers"
 	"github.com/dgageot/demoit/livereload"
+	"github.com/dgageot/demoit/rehearsal"
+	"github.com/dgageot/demoit/shell"
 	"github.com/gorilla/mux"
 	"github.com/rjeczalik/notify"
 )
 
 func main() {
-	flags.DevMode = flag.Bool("dev", false, "dev mode with live reload")
-	flags.WebServerPort = flag.Int("port", 8888, "presentation port")
-	flags.WebServerHost = flag.String("host", "localhost", "host to bind the presentation server")
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "rehearse":
+			rehearse(os.Args[2:])
+			return
+		case "check":
+			checkDeck(os.Args[2:])
+			return
+		}
+	}
+
+	// The flags override the values of the configuration file.
+	overrides := config.Config{Proxy: map[string]config.Proxy{}}
+	var stripHeaders []string
+	flag.BoolVar(&overrides.Dev, "dev", false, "dev mode with live reload")
+	flag.IntVar(&overrides.Port, "port", 8888, "presentation port")
+	flag.StringVar(&overrides.Host, "host", "localhost", "host to bind the presentation server")
+	flag.Var((*listFlag)(&overrides.Security.PingAllowlist), "ping-allow", "comma separated list of extra hosts or urls that can be pinged")
+	flag.Var((*listFlag)(&stripHeaders), "proxy-strip", "comma separated list of response headers removed by the proxy, e.g. X-Frame-Options")
+	flag.Var(proxyFlag(overrides.Proxy), "proxy", "name=url of a local target served under /proxy/name/ (can be repeated)")
 	flag.Parse()
 	if args := flag.Args(); len(args) > 0 {
 		files.Root = args[0]
 	}
 
+	cfg, err := config.Load(files.Root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	applyFlags(cfg, overrides, stripHeaders)
+	config.Current = cfg
+
+	// Styles are registered before the configuration, that uses them, is verified.
+	if err := loadStyles(); err != nil {
+		log.Fatal(err)
+	}
+
 	if err := handlers.VerifyConfiguration(); err != nil {
 		log.Fatal(err)
 	}
 
+	if removed := shell.Sweep(); removed > 0 {
+		fmt.Println("Removed", removed, "temporary files left over by previous runs")
+	}
+
 	r := mux.NewRouter()
 	r.HandleFunc("/{id:[0-9]*}", handlers.Step).Methods("GET")
 	r.HandleFunc("/last", handlers.LastStep).Methods("GET")
+	r.HandleFunc("/sourceCode.css", handlers.CodeCSS).Methods("GET")
 	r.PathPrefix("/sourceCode/").HandlerFunc(handlers.Code).Methods("GET")
+	r.PathPrefix("/sourceCode/").HandlerFunc(handlers.SaveCode).Methods("PUT")
+	r.PathPrefix("/diff/").HandlerFunc(handlers.Diff).Methods("GET")
 	r.HandleFunc("/shell/", handlers.Shell).Methods("GET")
 	r.HandleFunc("/shell/{folder}", handlers.Shell).Methods("GET")
 	r.HandleFunc("/terminal", handlers.TerminalPage).Methods("GET")
 	r.HandleFunc("/ws/terminal", handlers.TerminalWebSocket)
+	r.PathPrefix("/proxy/{name}").HandlerFunc(handlers.Proxy)
 	r.PathPrefix("/ping").HandlerFunc(handlers.Ping).Methods("HEAD", "GET")
 	r.PathPrefix("/js/").HandlerFunc(handlers.Static).Methods("GET")
 	r.PathPrefix("/fonts/").HandlerFunc(handlers.Static).Methods("GET")
@@ -45,8 +95,8 @@ func main() {
 	r.HandleFunc("/qrcode", handlers.QRCode).Methods("GET")
 
 	// Live Reload Server.
-	if *flags.DevMode {
-		lr := livereload.New(*flags.WebServerPort)
+	if cfg.Dev {
+		lr := livereload.New(cfg.Port)
 		lr.RegisterHandlers(r)
 
 		events := make(chan notify.EventInfo, 1)
@@ -59,6 +109,11 @@ func main() {
 				// TODO: Ignore files under .git
 				// TODO: Debounce
 				fmt.Println(event)
+				if isStyle(event.Path()) {
+					if err := loadStyles(); err != nil {
+						fmt.Println(err)
+					}
+				}
 				lr.Reload(event.Path())
 			}
 		}()
@@ -66,7 +121,193 @@ func main() {
 		fmt.Println(`"Dev Mode" to live reload your slides can be enabled with '--dev'`)
 	}
 
-	addr := flags.WebServerAddress()
+	addr := cfg.Address()
 	fmt.Println("Welcome to DemoIt. Please, open http://" + addr)
-	log.Fatal(http.ListenAndServe(addr, r))
+
+	// Requests, including the long-lived ones, are cancelled on shutdown.
+	ctx, cancel := context.WithCancel(context.Background())
+	server := &http.Server{
+		Addr:        addr,
+		Handler:     r,
+		BaseContext: func(net.Listener) context.Context { return ctx },
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+		// A second Ctrl-C exits immediately.
+		signal.Stop(signals)
+
+		fmt.Println("Shutting down...")
+		cancel()
+		shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), cfg.Terminal.ShutdownGrace)
+		defer cancelShutdown()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Println("Unable to shutdown the server:", err)
+		}
+
+		shell.Shutdown(cfg.Terminal.ShutdownGrace)
+	}()
+
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+	<-stopped
+}
+
+// rehearse runs the scripted terminals of a deck headlessly and
+// exits with a non-zero code if any of them fails.
+func rehearse(args []string) {
+	loadDeck("rehearse", `Runs the script of each <web-term script="..." expect="regexp" expect-exit="0" timeout="30s">.`, args)
+
+	if err := config.Current.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	failures, err := rehearsal.Run(files.Root, os.Stdout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if failures > 0 {
+		os.Exit(1)
+	}
+}
+
+// checkDeck lints a deck and exits with a non-zero code if any problem is found.
+func checkDeck(args []string) {
+	loadDeck("check", "Checks the slides, the assets and the files they reference, and demoit.yaml.", args)
+
+	problems, err := check.Run(files.Root, os.Stdout)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if problems > 0 {
+		os.Exit(1)
+	}
+}
+
+// loadDeck parses the arguments of a subcommand, an optional
+// folder, and loads the deck's configuration.
+func loadDeck(name, description string, args []string) {
+	flagSet := flag.NewFlagSet(name, flag.ExitOnError)
+	flagSet.Usage = func() {
+		fmt.Fprintf(flagSet.Output(), "Usage: demoit %s [folder]\n", name)
+		fmt.Fprintln(flagSet.Output(), description)
+	}
+	_ = flagSet.Parse(args)
+	if flagSet.NArg() > 0 {
+		files.Root = flagSet.Arg(0)
+	}
+
+	cfg, err := config.Load(files.Root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	config.Current = cfg
+
+	if _, err := codestyle.Load(files.Root); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// loadStyles registers the code styles of the deck.
+func loadStyles() error {
+	names, err := codestyle.Load(files.Root)
+	if len(names) > 0 {
+		fmt.Println("Using code styles", strings.Join(names, ", "))
+	}
+
+	return err
+}
+
+// isStyle tells if a file changed in dev mode is one of the code styles.
+func isStyle(path string) bool {
+	folder, err := filepath.Abs(filepath.Join(files.Root, codestyle.Folder))
+	if err != nil {
+		return false
+	}
+
+	return filepath.Dir(path) == folder
+}
+
+// applyFlags overrides the configuration with the flags set on the command line.
+func applyFlags(cfg *config.Config, overrides config.Config, stripHeaders []string) {
+	set := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) { set[f.Name] = true })
+
+	if set["dev"] {
+		cfg.Dev = overrides.Dev
+	}
+	if set["port"] {
+		cfg.Port = overrides.Port
+	}
+	if set["host"] {
+		cfg.Host = overrides.Host
+	}
+	if set["ping-allow"] {
+		cfg.Security.PingAllowlist = overrides.Security.PingAllowlist
+	}
+	if set["proxy"] {
+		if cfg.Proxy == nil {
+			cfg.Proxy = map[string]config.Proxy{}
+		}
+		for name, proxy := range overrides.Proxy {
+			cfg.Proxy[name] = config.Proxy{Target: proxy.Target, StripHeaders: cfg.Proxy[name].StripHeaders}
+		}
+	}
+	if set["proxy-strip"] {
+		for name, proxy := range cfg.Proxy {
+			proxy.StripHeaders = stripHeaders
+			cfg.Proxy[name] = proxy
+		}
+	}
+}
+
+// listFlag is a comma separated list of values, ignoring empty values.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	if l == nil {
+		return ""
+	}
+
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(list string) error {
+	*l = nil
+	for value := range strings.SplitSeq(list, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			*l = append(*l, value)
+		}
+	}
+
+	return nil
+}
+
+// proxyFlag is a set of name=url proxy targets that can be repeated on the command line.
+type proxyFlag map[string]config.Proxy
+
+func (p proxyFlag) String() string {
+	entries := make([]string, 0, len(p))
+	for name, proxy := range p {
+		entries = append(entries, name+"="+proxy.Target)
+	}
+	slices.Sort(entries)
+
+	return strings.Join(entries, ",")
+}
+
+func (p proxyFlag) Set(value string) error {
+	name, target, found := strings.Cut(value, "=")
+	if !found || name == "" || target == "" {
+		return errors.New("proxy target should be name=url")
+	}
+
+	p[name] = config.Proxy{Target: target}
+	return nil
 }
@@ -0,0 +1,179 @@
+// Package regions finds named regions of source files: the lines between
+// two markers in comments or the declaration of a symbol.
+package regions
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrNotFound is returned when a file has no region with a given name.
+var ErrNotFound = errors.New("not found")
+
+// markerRegexp finds the `demoit:start name` and `demoit:end name` markers,
+// whatever the syntax of the comments they're in.
+var markerRegexp = regexp.MustCompile(`demoit:(start|end)\s+(\S+)`)
+
+// rangeRegexp matches a range of lines, e.g. 12-20.
+var rangeRegexp = regexp.MustCompile(`^([0-9]+)-([0-9]+)$`)
+
+// Region is a range of lines of a file, 1-based and inclusive.
+type Region struct {
+	Start int
+	End   int
+}
+
+// Find finds a region by name: the lines between `demoit:start name` and
+// `demoit:end name` markers or the declaration of a symbol: for Go files,
+// a func, a method (Type.Method), a type, a var or a const and, for the
+// other languages chroma knows, a function or a class.
+func Find(filename string, content []byte, name string) (Region, error) {
+	region, found, err := findMarkers(content, name)
+	if err != nil || found {
+		return region, err
+	}
+
+	if filepath.Ext(filename) != ".go" {
+		return findSymbol(filename, content, name)
+	}
+
+	return findGoSymbol(filename, content, name)
+}
+
+// Parse finds a region given either as a line range, e.g. 12-20,
+// or by name. The region must be inside the file.
+func Parse(filename string, content []byte, spec string) (Region, error) {
+	var region Region
+	if match := rangeRegexp.FindStringSubmatch(spec); match != nil {
+		region.Start, _ = strconv.Atoi(match[1])
+		region.End, _ = strconv.Atoi(match[2])
+	} else {
+		var err error
+		if region, err = Find(filename, content, spec); err != nil {
+			return Region{}, err
+		}
+	}
+
+	if lineCount := LineCount(content); region.Start < 1 || region.End < region.Start || region.End > lineCount {
+		return Region{}, fmt.Errorf("range %d-%d is out of the file's %d lines", region.Start, region.End, lineCount)
+	}
+
+	return region, nil
+}
+
+// LineCount returns the number of lines of a file.
+func LineCount(content []byte) int {
+	count := bytes.Count(content, []byte("\n"))
+	if len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")) {
+		count++
+	}
+
+	return count
+}
+
+func findMarkers(content []byte, name string) (Region, bool, error) {
+	start := 0
+	for i, line := range strings.Split(string(content), "\n") {
+		for _, match := range markerRegexp.FindAllStringSubmatch(line, -1) {
+			if match[2] != name {
+				continue
+			}
+
+			switch {
+			case match[1] == "start" && start == 0:
+				start = i + 1
+			case match[1] == "end" && start != 0:
+				if i <= start {
+					return Region{}, false, fmt.Errorf("region %s is empty", name)
+				}
+				return Region{Start: start + 1, End: i}, true, nil
+			}
+		}
+	}
+
+	if start != 0 {
+		return Region{}, false, fmt.Errorf("demoit:start %s on line %d has no demoit:end", name, start)
+	}
+
+	return Region{}, false, nil
+}
+
+func findGoSymbol(filename string, content []byte, name string) (Region, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, content, parser.SkipObjectResolution)
+	if err != nil {
+		return Region{}, fmt.Errorf("unable to parse %s: %w", filename, err)
+	}
+
+	region := func(node ast.Node) Region {
+		return Region{Start: fset.Position(node.Pos()).Line, End: fset.Position(node.End()).Line}
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if funcName(decl) == name {
+				return region(decl), nil
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if !specHasName(spec, name) {
+					continue
+				}
+				// Include the keyword of a declaration with no parentheses.
+				if !decl.Lparen.IsValid() {
+					return region(decl), nil
+				}
+				return region(spec), nil
+			}
+		}
+	}
+
+	return Region{}, fmt.Errorf("no demoit:start %s marker or Go symbol %s: %w", name, name, ErrNotFound)
+}
+
+// funcName is the name of a func, or Type.Method for a method.
+func funcName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return decl.Name.Name
+	}
+
+	recv := decl.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	switch expr := recv.(type) {
+	case *ast.IndexExpr:
+		recv = expr.X
+	case *ast.IndexListExpr:
+		recv = expr.X
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return ident.Name + "." + decl.Name.Name
+	}
+
+	return decl.Name.Name
+}
+
+func specHasName(spec ast.Spec, name string) bool {
+	switch spec := spec.(type) {
+	case *ast.TypeSpec:
+		return spec.Name.Name == name
+	case *ast.ValueSpec:
+		for _, ident := range spec.Names {
+			if ident.Name == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
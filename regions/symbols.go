@@ -0,0 +1,135 @@
+package regions
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/lexers"
+)
+
+// findSymbol finds the declaration of a function or a class, in a file that's
+// not Go, with the tokens of chroma's lexer for the file. The declaration
+// starts on the line of its name and ends with its body: up to the matching
+// closing brace or, without braces, the lines indented deeper than the name's.
+func findSymbol(filename string, content []byte, name string) (Region, error) {
+	lexer := lexers.Match(filepath.Base(filename))
+	if lexer == nil {
+		return Region{}, fmt.Errorf("no demoit:start %s marker, and symbols can't be found in %s: %w", name, filepath.Base(filename), ErrNotFound)
+	}
+
+	tokens, err := chroma.Tokenise(lexer, nil, string(content))
+	if err != nil {
+		return Region{}, fmt.Errorf("unable to tokenize %s: %w", filename, err)
+	}
+
+	line := 1
+	previous := ""
+	for i, token := range tokens {
+		if token.Value == name && isDeclaration(token, previous) {
+			return Region{Start: line, End: bodyEnd(tokens[i+1:], line, strings.Split(string(content), "\n"))}, nil
+		}
+		line += strings.Count(token.Value, "\n")
+		if value := strings.TrimSpace(token.Value); value != "" {
+			previous = value
+		}
+	}
+
+	return Region{}, fmt.Errorf("no demoit:start %s marker or %s function or class %s: %w", name, lexer.Config().Name, name, ErrNotFound)
+}
+
+// declarationKeywords introduce the name of a function or a class, for the
+// lexers that don't tell these names from the other ones, e.g. JavaScript.
+var declarationKeywords = map[string]bool{
+	"class": true, "def": true, "fn": true, "fun": true, "func": true, "function": true,
+}
+
+// isDeclaration tests if a token is the name of a function or a class,
+// given the token that precedes it.
+func isDeclaration(token chroma.Token, previous string) bool {
+	switch {
+	case token.Type == chroma.NameFunction || token.Type == chroma.NameClass:
+		return true
+	case token.Type.InCategory(chroma.Name):
+		return declarationKeywords[previous]
+	default:
+		return false
+	}
+}
+
+// bodyEnd finds the last line of a declaration, given the tokens that
+// follow its name.
+func bodyEnd(tokens []chroma.Token, start int, lines []string) int {
+	line := start
+	depth := 0
+	braces := 0
+	for i, token := range tokens {
+		if token.Type == chroma.Punctuation || token.Type == chroma.Operator {
+			for _, c := range token.Value {
+				switch {
+				case c == '(' || c == '[':
+					depth++
+				case c == ')' || c == ']':
+					depth--
+				case c == '{' && depth <= 0:
+					braces++
+				case c == '}' && depth <= 0 && braces > 0:
+					braces--
+					if braces == 0 {
+						return line
+					}
+				case c == ';' && depth <= 0 && braces == 0:
+					return line
+				}
+			}
+		}
+
+		newLines := strings.Count(token.Value, "\n")
+		// Past the first line of a declaration with no braces, unless
+		// its body starts with a brace on the next line.
+		if newLines > 0 && depth <= 0 && braces == 0 && !nextIsBrace(tokens[i+1:]) {
+			return indentedEnd(lines, start)
+		}
+		line += newLines
+	}
+
+	return line
+}
+
+// nextIsBrace tests if the first token that's not blank is an opening brace.
+func nextIsBrace(tokens []chroma.Token) bool {
+	for _, token := range tokens {
+		if value := strings.TrimSpace(token.Value); value != "" {
+			return strings.HasPrefix(value, "{")
+		}
+	}
+
+	return false
+}
+
+// indentedEnd finds the last line indented deeper than the start line,
+// or the line that closes the block with end, e.g. in Ruby or Lua.
+func indentedEnd(lines []string, start int) int {
+	indent := indentation(lines[start-1])
+
+	end := start
+	for i := start; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "" {
+			continue
+		}
+		if indentation(lines[i]) <= indent {
+			if strings.TrimSpace(lines[i]) == "end" {
+				end = i + 1
+			}
+			break
+		}
+		end = i + 1
+	}
+
+	return end
+}
+
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " \t"))
+}
@@ -0,0 +1,38 @@
+package regions
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFindSymbol(t *testing.T) {
+	tests := []struct {
+		filename, content, name string
+		expected                Region
+	}{
+		{"app.py", "import os\n\ndef hello(name: str) -> str:\n    print(name)\n\n    return name\n\nhello('world')\n", "hello", Region{3, 6}},
+		{"app.py", "class Greeter:\n    def greet(self):\n        pass\n\nx = 1\n", "Greeter", Region{1, 3}},
+		{"app.js", "const a = 1;\n\nfunction hello(name) {\n  if (name) {\n    console.log(name);\n  }\n}\n", "hello", Region{3, 7}},
+		{"app.ts", "export function hello(name: string): string {\n  return name;\n}\n", "hello", Region{1, 3}},
+		{"App.java", "package demo;\n\npublic class App\n{\n  void run() {\n  }\n}\n", "App", Region{3, 7}},
+		{"app.rb", "def hello\n  puts 'hello'\nend\n\nhello\n", "hello", Region{1, 3}},
+	}
+	for _, test := range tests {
+		region, err := Find(test.filename, []byte(test.content), test.name)
+		if err != nil {
+			t.Errorf("%s %s: %v", test.filename, test.name, err)
+			continue
+		}
+		if region != test.expected {
+			t.Errorf("%s %s: got %+v, expected %+v", test.filename, test.name, region, test.expected)
+		}
+	}
+}
+
+func TestFindSymbolNotFound(t *testing.T) {
+	for _, filename := range []string{"app.py", "notes.unknown-language"} {
+		if _, err := Find(filename, []byte("def hello():\n    pass\n"), "missing"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("%s: got %v, expected ErrNotFound", filename, err)
+		}
+	}
+}
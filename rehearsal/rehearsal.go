@@ -0,0 +1,187 @@
+// Package rehearsal runs the scripted terminals of a deck headlessly, to
+// check before a talk that all the demos still work.
+package rehearsal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgageot/demoit/deck"
+	"github.com/dgageot/demoit/shell"
+	"github.com/dgageot/demoit/terminal"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+	// outputLines is how many lines of output are shown for a failure.
+	outputLines = 10
+)
+
+// scripted is a <web-term> with a script attribute. The optional expect,
+// expect-exit and timeout attributes are the regexp the output should match,
+// with ^ and $ matching at line boundaries, the expected exit code, 0 by
+// default, and how long the script can run.
+type scripted struct {
+	script     string
+	expect     *regexp.Regexp
+	expectExit int
+	timeout    time.Duration
+}
+
+// Run rehearses all the scripted terminals of a deck, reports on out and
+// returns the number of failures.
+func Run(folder string, out io.Writer) (int, error) {
+	slides, err := deck.Read(folder)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		count        int
+		failedSlides []int
+	)
+	for i, slide := range slides {
+		for _, term := range slide.Elements("web-term") {
+			if _, found := term.Attrs["script"]; !found {
+				continue
+			}
+			count++
+
+			fmt.Fprintf(out, "Slide %d, %s:%d: %s: ", i, deck.FileName, term.Line, summary(term.Attrs["script"]))
+			duration, err := rehearse(term)
+			if err != nil {
+				fmt.Fprintf(out, "FAILED\n%s\n", indent(err.Error()))
+				if !slices.Contains(failedSlides, i) {
+					failedSlides = append(failedSlides, i)
+				}
+				continue
+			}
+			fmt.Fprintf(out, "ok (%s)\n", duration.Round(100*time.Millisecond))
+		}
+	}
+
+	failures := len(failedSlides)
+	switch {
+	case count == 0:
+		fmt.Fprintln(out, "No scripted <web-term> found")
+	case failures == 0:
+		fmt.Fprintf(out, "All %d scripted terminal(s) passed\n", count)
+	default:
+		fmt.Fprintf(out, "Failures on %d slide(s): %s\n", failures, joinInts(failedSlides))
+	}
+
+	return failures, nil
+}
+
+// rehearse runs a scripted terminal, checks the expectations and
+// returns how long the script ran.
+func rehearse(term deck.Element) (time.Duration, error) {
+	s, err := parseScripted(term)
+	if err != nil {
+		return 0, err
+	}
+
+	opts, err := terminal.Options(terminal.Query(term.Attrs))
+	if err != nil {
+		return 0, err
+	}
+	opts.Quiet = true
+
+	result, err := shell.Run(opts, s.script, s.timeout)
+	if err != nil {
+		return 0, withOutput(err.Error(), result.Output)
+	}
+
+	if result.ExitCode != s.expectExit {
+		return 0, withOutput(fmt.Sprintf("exit code %d, expected %d", result.ExitCode, s.expectExit), result.Output)
+	}
+	if s.expect != nil && !s.expect.MatchString(result.Output) {
+		return 0, withOutput(fmt.Sprintf("output doesn't match %q", strings.TrimPrefix(s.expect.String(), "(?m)")), result.Output)
+	}
+
+	return result.Duration, nil
+}
+
+func parseScripted(term deck.Element) (scripted, error) {
+	s := scripted{
+		script:  term.Attrs["script"],
+		timeout: defaultTimeout,
+	}
+	if strings.TrimSpace(s.script) == "" {
+		return s, errors.New("the script is empty")
+	}
+
+	if expect, found := term.Attrs["expect"]; found {
+		re, err := regexp.Compile("(?m)" + expect)
+		if err != nil {
+			return s, fmt.Errorf("invalid expect %q: %w", expect, err)
+		}
+		s.expect = re
+	}
+
+	if expectExit, found := term.Attrs["expect-exit"]; found {
+		code, err := strconv.Atoi(expectExit)
+		if err != nil {
+			return s, fmt.Errorf("invalid expect-exit %q", expectExit)
+		}
+		s.expectExit = code
+	}
+
+	if timeout, found := term.Attrs["timeout"]; found {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil || duration <= 0 {
+			return s, fmt.Errorf("invalid timeout %q", timeout)
+		}
+		s.timeout = duration
+	}
+
+	return s, nil
+}
+
+// summary shortens a script to fit on a line.
+func summary(script string) string {
+	line, _, multiline := strings.Cut(strings.TrimSpace(script), "\n")
+	if multiline || len(line) > 50 {
+		return line[:min(len(line), 47)] + "..."
+	}
+
+	return line
+}
+
+// withOutput builds an error with the last lines of a script's output.
+func withOutput(message, output string) error {
+	if strings.TrimSpace(output) == "" {
+		return errors.New(message)
+	}
+
+	return fmt.Errorf("%s\n%s", message, tail(output))
+}
+
+// tail keeps the last lines of an output.
+func tail(output string) string {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if len(lines) > outputLines {
+		lines = lines[len(lines)-outputLines:]
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func indent(text string) string {
+	return "    " + strings.ReplaceAll(text, "\n", "\n    | ")
+}
+
+func joinInts(values []int) string {
+	var texts []string
+	for _, value := range values {
+		texts = append(texts, strconv.Itoa(value))
+	}
+
+	return strings.Join(texts, ", ")
+}
@@ -0,0 +1,29 @@
+package shell
+
+import "io"
+
+// Backend runs what's displayed in a terminal: a local shell, a sandboxed
+// shell, a remote shell or a recording. It's bridged to the browser by HandleWebSocket.
+type Backend interface {
+	// Start starts the terminal with the given size.
+	Start(cols, rows uint16) error
+	// Resize resizes the terminal.
+	Resize(cols, rows uint16) error
+	// Read reads the terminal's output and Write sends it the user's input.
+	// Read fails once the terminal has exited.
+	io.ReadWriter
+	// Close ends the terminal and frees its resources.
+	Close() error
+}
+
+// newBackend selects the backend described by the options.
+func newBackend(opts Options) (Backend, error) {
+	if opts.Replay != "" {
+		return newReplayBackend(opts.Replay, opts.Quiet), nil
+	}
+	if opts.SSH != nil {
+		return newSSHBackend(opts), nil
+	}
+
+	return newLocalBackend(opts)
+}
@@ -0,0 +1,70 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// legacyTempFiles matches the temporary files created by previous versions
+// of demoit, before they were grouped in a session folder.
+var legacyTempFiles = regexp.MustCompile(`^demoit([0-9]+|-bashrc-[0-9]+|-zdotdir-[0-9]+)$`)
+
+// Sweep removes the temporary files left over by terminals of demoit
+// processes that are gone. It returns the number of files removed.
+func Sweep() int {
+	entries, err := os.ReadDir(os.TempDir())
+	if err != nil {
+		return 0
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if rest, found := strings.CutPrefix(name, sessionPrefix); found {
+			pidPart, _, _ := strings.Cut(rest, "-")
+			if pid, err := strconv.Atoi(pidPart); err == nil && isRunning(pid) {
+				continue
+			}
+		} else if !legacyTempFiles.MatchString(name) {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(os.TempDir(), name)); err != nil {
+			fmt.Fprintln(os.Stderr, "unable to remove", name, err)
+			continue
+		}
+		removed++
+	}
+
+	return removed
+}
+
+// Cleanup removes the temporary files of the terminals still running.
+func Cleanup() {
+	liveSessions.Range(func(key, _ any) bool {
+		if s, ok := key.(*session); ok {
+			s.close()
+		}
+		return true
+	})
+}
+
+// isRunning tests if a process exists.
+func isRunning(pid int) bool {
+	if pid == os.Getpid() {
+		return true
+	}
+
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	return process.Signal(syscall.Signal(0)) == nil
+}
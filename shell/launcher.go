@@ -0,0 +1,38 @@
+package shell
+
+import (
+	"os/exec"
+	"strings"
+)
+
+// launcher prepares the command that starts a terminal's shell.
+type launcher interface {
+	// command returns the command that runs shellCommand with sh.
+	command(shellCommand string) (*exec.Cmd, error)
+	// release frees what the command needed, once it has exited.
+	release()
+}
+
+// newLauncher returns the launcher for a session:
+// a sandbox if requested, plain exec otherwise.
+func newLauncher(s *session, opts Options) (launcher, error) {
+	if opts.Sandbox != nil {
+		return newSandbox(s, opts)
+	}
+
+	return execLauncher{}, nil
+}
+
+// execLauncher runs the shell as a regular process of the current user.
+type execLauncher struct{}
+
+func (execLauncher) command(shellCommand string) (*exec.Cmd, error) {
+	return exec.Command("sh", "-c", shellCommand), nil
+}
+
+func (execLauncher) release() {}
+
+// quote quotes a string for sh.
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,114 @@
+package shell
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// pingPeriod is how often the WebSocket is pinged to detect dead browser tabs.
+	pingPeriod = 30 * time.Second
+	// pongWait is how long to wait for any message, pongs included, before
+	// considering the browser gone.
+	pongWait = 2 * pingPeriod
+	// idleWarning is how long before the idle timeout a warning is shown.
+	idleWarning = time.Minute
+)
+
+// errTooManySessions is returned when the maximum number of terminals is reached.
+var errTooManySessions = errors.New("too many terminals open")
+
+var (
+	sessionsLock sync.Mutex
+	sessionCount int
+)
+
+// Limits bound the number and the life of terminals. Zero values mean no limit.
+type Limits struct {
+	// MaxSessions is the maximum number of terminals open at the same time.
+	MaxSessions int
+	// IdleTimeout closes a terminal that received no input for that long.
+	IdleTimeout time.Duration
+	// MaxDuration closes a terminal open for that long.
+	MaxDuration time.Duration
+}
+
+// acquire counts a new terminal, unless there are already too many.
+func (l Limits) acquire() error {
+	sessionsLock.Lock()
+	defer sessionsLock.Unlock()
+
+	if l.MaxSessions > 0 && sessionCount >= l.MaxSessions {
+		return errTooManySessions
+	}
+	sessionCount++
+
+	return nil
+}
+
+// release counts a terminal that was closed.
+func (Limits) release() {
+	sessionsLock.Lock()
+	defer sessionsLock.Unlock()
+
+	sessionCount--
+}
+
+// watch closes a terminal when it's idle or open for too long. Each value
+// received on activity resets the idle timer. Warnings are shown with
+// banner and the terminal is closed by calling stop.
+func (l Limits) watch(activity <-chan struct{}, done <-chan struct{}, banner func(string), stop func()) {
+	never := make(<-chan time.Time)
+
+	maxDuration := never
+	if l.MaxDuration > 0 {
+		timer := time.NewTimer(l.MaxDuration)
+		defer timer.Stop()
+		maxDuration = timer.C
+	}
+
+	warnAfter := l.IdleTimeout - min(idleWarning, l.IdleTimeout/2)
+	var warned bool
+	idle := time.NewTimer(warnAfter)
+	defer idle.Stop()
+	idleTimeout := never
+	if l.IdleTimeout > 0 {
+		idleTimeout = idle.C
+	}
+
+	for {
+		select {
+		case <-done:
+			return
+
+		case <-activity:
+			if l.IdleTimeout > 0 {
+				idle.Reset(warnAfter)
+				warned = false
+			}
+
+		case <-idleTimeout:
+			if !warned {
+				banner(fmt.Sprintf("This terminal will be closed in %s if it stays idle.", l.IdleTimeout-warnAfter))
+				idle.Reset(l.IdleTimeout - warnAfter)
+				warned = true
+				continue
+			}
+			banner(fmt.Sprintf("This terminal was closed after %s of inactivity.", l.IdleTimeout))
+			stop()
+			return
+
+		case <-maxDuration:
+			banner(fmt.Sprintf("This terminal was closed after reaching its maximum duration of %s.", l.MaxDuration))
+			stop()
+			return
+		}
+	}
+}
+
+// bannerText formats a message written into a terminal, on its own line.
+func bannerText(message string) []byte {
+	return []byte("\r\n\x1b[1;33m[demoit] " + message + "\x1b[0m\r\n")
+}
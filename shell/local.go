@@ -0,0 +1,107 @@
+package shell
+
+import (
+	"os"
+	"os/exec"
+
+	"github.com/creack/pty"
+)
+
+// localBackend runs a shell, possibly sandboxed, in a local PTY.
+type localBackend struct {
+	opts         Options
+	session      *session
+	launcher     launcher
+	shellCommand string
+
+	ptmx   *os.File
+	exited chan struct{}
+	state  *os.ProcessState
+}
+
+func newLocalBackend(opts Options) (*localBackend, error) {
+	session, err := newSession(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	shellCommand, err := session.command(opts)
+	if err != nil {
+		session.close()
+		return nil, err
+	}
+
+	launcher, err := newLauncher(session, opts)
+	if err != nil {
+		session.close()
+		return nil, err
+	}
+
+	return &localBackend{
+		opts:         opts,
+		session:      session,
+		launcher:     launcher,
+		shellCommand: shellCommand,
+	}, nil
+}
+
+// Start starts the shell in a PTY. The shell leads a new session and process group.
+func (b *localBackend) Start(cols, rows uint16) error {
+	cmd, err := b.launcher.command(b.shellCommand)
+	if err != nil {
+		return err
+	}
+	cmd.Env = append(b.opts.Env, "TERM=xterm-256color")
+
+	ptmx, err := pty.StartWithSize(cmd, &pty.Winsize{Rows: rows, Cols: cols})
+	if err != nil {
+		return err
+	}
+	b.ptmx = ptmx
+	b.session.started(cmd.Process)
+
+	// Reap the shell as soon as it exits.
+	b.exited = make(chan struct{})
+	go func(cmd *exec.Cmd) {
+		b.state, _ = cmd.Process.Wait()
+		close(b.exited)
+	}(cmd)
+
+	return nil
+}
+
+func (b *localBackend) Resize(cols, rows uint16) error {
+	return pty.Setsize(b.ptmx, &pty.Winsize{Rows: rows, Cols: cols})
+}
+
+func (b *localBackend) Read(p []byte) (int, error) {
+	return b.ptmx.Read(p)
+}
+
+func (b *localBackend) Write(p []byte) (int, error) {
+	return b.ptmx.Write(p)
+}
+
+// Wait waits for the shell to exit and returns its exit code,
+// or -1 if it was killed.
+func (b *localBackend) Wait() int {
+	<-b.exited
+	if b.state == nil {
+		return -1
+	}
+
+	return b.state.ExitCode()
+}
+
+// Close hangs up the shell and every job it started, unless background
+// jobs are kept, then removes the temporary files.
+func (b *localBackend) Close() error {
+	if b.ptmx != nil {
+		_ = b.ptmx.Close()
+		b.session.hangup(b.opts.KeepBackgroundJobs, b.exited)
+	}
+	b.launcher.release()
+	b.session.close()
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package shell
+
+import (
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// sessionGroups lists the process groups of a session. With job control,
+// each job started from the shell runs in its own process group.
+func sessionGroups(sid int) []int {
+	groups := []int{sid}
+
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return groups
+	}
+
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+
+		stat, err := os.ReadFile("/proc/" + entry.Name() + "/stat")
+		if err != nil {
+			continue
+		}
+
+		// The command name, in parentheses, can contain spaces.
+		i := strings.LastIndexByte(string(stat), ')')
+		if i < 0 {
+			continue
+		}
+
+		// Fields after the command name: state, ppid, pgrp, session...
+		fields := strings.Fields(string(stat[i+1:]))
+		if len(fields) < 4 || fields[3] != strconv.Itoa(sid) {
+			continue
+		}
+
+		if pgrp, err := strconv.Atoi(fields[2]); err == nil && !slices.Contains(groups, pgrp) {
+			groups = append(groups, pgrp)
+		}
+	}
+
+	return groups
+}
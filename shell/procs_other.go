@@ -0,0 +1,9 @@
+//go:build !linux
+
+package shell
+
+// sessionGroups lists the process groups of a session. Only the
+// session leader's group is known on this platform.
+func sessionGroups(sid int) []int {
+	return []int{sid}
+}
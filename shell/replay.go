@@ -0,0 +1,147 @@
+package shell
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// replayBackend plays an asciicast v2 recording. The user's input isn't sent
+// anywhere: any key skips the current pause. The terminal stays open once
+// the recording is over.
+type replayBackend struct {
+	path  string
+	quiet bool
+
+	reader *io.PipeReader
+	writer *io.PipeWriter
+	skip   chan struct{}
+	stop   chan struct{}
+	once   sync.Once
+}
+
+// asciicastHeader is the first line of an asciicast v2 file.
+type asciicastHeader struct {
+	Version       int     `json:"version"`
+	IdleTimeLimit float64 `json:"idle_time_limit"`
+}
+
+func newReplayBackend(path string, quiet bool) *replayBackend {
+	reader, writer := io.Pipe()
+
+	return &replayBackend{
+		path:   path,
+		quiet:  quiet,
+		reader: reader,
+		writer: writer,
+		skip:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+	}
+}
+
+// Start plays the recording, at its own pace, whatever the terminal's size.
+func (b *replayBackend) Start(_, _ uint16) error {
+	file, err := os.Open(b.path)
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	var header asciicastHeader
+	if !scanner.Scan() || json.Unmarshal(scanner.Bytes(), &header) != nil || header.Version != 2 {
+		_ = file.Close()
+		return fmt.Errorf("%s is not an asciicast v2 recording", b.path)
+	}
+	if !b.quiet {
+		fmt.Println("Replaying", b.path)
+	}
+
+	go func() {
+		defer file.Close()
+
+		var last float64
+		for scanner.Scan() {
+			var event []any
+			if err := json.Unmarshal(scanner.Bytes(), &event); err != nil || len(event) != 3 {
+				continue
+			}
+			at, _ := event[0].(float64)
+			code, _ := event[1].(string)
+			data, _ := event[2].(string)
+			if code != "o" {
+				continue
+			}
+
+			pause := at - last
+			if header.IdleTimeLimit > 0 {
+				pause = min(pause, header.IdleTimeLimit)
+			}
+			last = at
+
+			if !b.wait(time.Duration(pause * float64(time.Second))) {
+				return
+			}
+			if _, err := b.writer.Write([]byte(data)); err != nil {
+				return
+			}
+		}
+
+		<-b.stop
+	}()
+
+	return nil
+}
+
+// wait pauses between two events. It returns false if the terminal is closed.
+func (b *replayBackend) wait(pause time.Duration) bool {
+	if pause <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(pause)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-b.skip:
+		return true
+	case <-b.stop:
+		return false
+	}
+}
+
+func (b *replayBackend) Resize(_, _ uint16) error {
+	return nil
+}
+
+func (b *replayBackend) Read(p []byte) (int, error) {
+	return b.reader.Read(p)
+}
+
+// Write skips the current pause.
+func (b *replayBackend) Write(p []byte) (int, error) {
+	select {
+	case b.skip <- struct{}{}:
+	default:
+	}
+
+	return len(p), nil
+}
+
+func (b *replayBackend) Close() error {
+	b.once.Do(func() {
+		close(b.stop)
+		_ = b.writer.CloseWithError(errors.New("replay closed"))
+		_ = b.reader.Close()
+	})
+
+	return nil
+}
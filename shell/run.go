@@ -0,0 +1,145 @@
+package shell
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Markers printed around the output of a script run headlessly. They are
+// typed split in two so that the echo of the input doesn't match them.
+var (
+	startMarker  = regexp.MustCompile(`DEMOIT_START\r?\n`)
+	exitMarker   = regexp.MustCompile(`DEMOIT_EXIT=([0-9]+)`)
+	escapeRegexp = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(\x07|\x1b\\)|\x1b[()][A-Za-z0-9]|\x1b[=>]|\r`)
+)
+
+var (
+	// errTimeout is returned when a script doesn't complete in time.
+	errTimeout = errors.New("timeout")
+	// errExited is returned when the terminal exits before the script
+	// completes and its exit code is unknown.
+	errExited = errors.New("terminal exited")
+)
+
+// waiter is implemented by the backends that know the exit code of their shell.
+type waiter interface {
+	Wait() int
+}
+
+// Result is the outcome of a script run in a headless terminal.
+type Result struct {
+	// Output is what the script printed, without terminal escape sequences.
+	Output string
+	// ExitCode is the exit code of the script's last command.
+	ExitCode int
+	Duration time.Duration
+}
+
+// Run runs a script in a headless terminal, started exactly like the ones
+// displayed in the browser, and waits for the script to complete. Each line
+// of the script is typed in the terminal. If the script doesn't complete
+// in time, what it printed so far is returned along with an error.
+func Run(opts Options, script string, timeout time.Duration) (Result, error) {
+	backend, err := newBackend(opts)
+	if err != nil {
+		return Result{}, err
+	}
+	defer backend.Close()
+
+	start := time.Now()
+	if err := backend.Start(120, 40); err != nil {
+		return Result{}, err
+	}
+
+	var (
+		lock   sync.Mutex
+		output strings.Builder
+	)
+	updated := make(chan struct{}, 1)
+	ended := make(chan struct{})
+	go func() {
+		defer close(ended)
+
+		buf := make([]byte, 4096)
+		for {
+			n, err := backend.Read(buf)
+			lock.Lock()
+			output.Write(buf[:n])
+			lock.Unlock()
+
+			select {
+			case updated <- struct{}{}:
+			default:
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// Don't echo the input, nor show the prompts, so that the output is
+	// only what the script prints.
+	input := "stty -echo; PS1= PS2= PROMPT_COMMAND=; echo DEMOIT\"\"_START\n" + strings.TrimSpace(script) + "\necho DEMOIT\"\"_EXIT=$?\n"
+	if _, err := backend.Write([]byte(input)); err != nil {
+		return Result{}, err
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		lock.Lock()
+		result := scriptResult(output.String())
+		lock.Unlock()
+		result.Duration = time.Since(start)
+		if result.ExitCode >= 0 {
+			return result, nil
+		}
+
+		select {
+		case <-updated:
+		case <-ended:
+			// The script ended the shell, e.g. with exit.
+			lock.Lock()
+			result = scriptResult(output.String())
+			lock.Unlock()
+			result.Duration = time.Since(start)
+			if result.ExitCode >= 0 {
+				return result, nil
+			}
+
+			w, ok := backend.(waiter)
+			if !ok {
+				return result, errExited
+			}
+			result.ExitCode = w.Wait()
+			return result, nil
+		case <-deadline.C:
+			return result, fmt.Errorf("%w after %s", errTimeout, timeout)
+		}
+	}
+}
+
+// scriptResult extracts the script's output and exit code from the
+// terminal's output. The exit code is -1 until the script completes.
+func scriptResult(terminal string) Result {
+	if loc := startMarker.FindStringIndex(terminal); loc != nil {
+		terminal = terminal[loc[1]:]
+	} else {
+		terminal = ""
+	}
+
+	result := Result{ExitCode: -1}
+	if loc := exitMarker.FindStringSubmatchIndex(terminal); loc != nil {
+		result.ExitCode, _ = strconv.Atoi(terminal[loc[2]:loc[3]])
+		terminal = terminal[:loc[0]]
+	}
+	result.Output = strings.Trim(escapeRegexp.ReplaceAllString(terminal, ""), "\n")
+
+	return result
+}
@@ -0,0 +1,17 @@
+package shell
+
+// Sandbox isolates a terminal from the host. The shell runs in new user,
+// mount, PID and network namespaces. Its changes to the demo folder are
+// written to a copy-on-write layer, discarded when the terminal exits,
+// and the rest of the filesystem is read-only.
+//
+// Resources are capped with cgroups v2 when the current cgroup can be
+// delegated to. Empty limits are not set.
+type Sandbox struct {
+	// MemoryMax is written to memory.max, e.g. 512M.
+	MemoryMax string
+	// PidsMax is written to pids.max, e.g. 256.
+	PidsMax string
+	// CPUMax is written to cpu.max, e.g. "50000 100000" for half a CPU.
+	CPUMax string
+}
@@ -0,0 +1,209 @@
+package shell
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+const cgroupRoot = "/sys/fs/cgroup"
+
+// sandbox runs the shell in new namespaces. The session's folder holds the
+// copy-on-write layer of the demo folder.
+type sandbox struct {
+	dir    string
+	upper  string
+	work   string
+	stage  string
+	home   string
+	hidden []string
+	cgroup *os.File
+}
+
+func newSandbox(s *session, opts Options) (launcher, error) {
+	dir, err := filepath.Abs(opts.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	root := filepath.Join(s.dir, "sandbox")
+	sb := &sandbox{
+		dir:    dir,
+		upper:  filepath.Join(root, "upper"),
+		work:   filepath.Join(root, "work"),
+		stage:  filepath.Join(root, "stage"),
+		home:   filepath.Join(root, "home"),
+		hidden: hiddenDirs(),
+	}
+	for _, hidden := range sb.hidden {
+		if isInDir(root, hidden) {
+			return nil, fmt.Errorf("the sandbox can't be created in %s, which is hidden from the sandbox", root)
+		}
+	}
+
+	for _, dir := range []string{sb.upper, sb.work, sb.stage, sb.home} {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("unable to create sandbox folder: %w", err)
+		}
+	}
+
+	cgroup, err := createCgroup(filepath.Base(s.dir), opts.Sandbox)
+	if err != nil {
+		fmt.Println("Unable to cap the sandbox's resources:", err)
+	}
+	sb.cgroup = cgroup
+
+	return sb, nil
+}
+
+// command runs the shell as root of a new user namespace, mapped to the
+// current user. A setup script mounts the copy-on-write demo folder, hides
+// the home folders behind empty tmpfs, mounts a private home folder, makes
+// every other mount read-only and mounts /proc for the new PID namespace,
+// before starting the shell. The demo folder is mounted aside then moved
+// in place, since it can be in a hidden home folder. If any step fails,
+// the shell is not started.
+func (sb *sandbox) command(shellCommand string) (*exec.Cmd, error) {
+	setup := []string{
+		"set -e",
+		"mount --make-rprivate /",
+		fmt.Sprintf("mount -t overlay overlay -o %s %s", quote("lowerdir="+overlayPath(sb.dir)+",upperdir="+overlayPath(sb.upper)+",workdir="+overlayPath(sb.work)), quote(sb.stage)),
+		fmt.Sprintf("mount -t tmpfs tmpfs %s", quote(sb.home)),
+	}
+	for _, hidden := range sb.hidden {
+		setup = append(setup, fmt.Sprintf("if [ -d %[1]s ]; then mount -t tmpfs -o mode=0755 tmpfs %[1]s; fi", quote(hidden)))
+	}
+	setup = append(setup,
+		fmt.Sprintf("mkdir -p %s", quote(sb.dir)),
+		fmt.Sprintf("mount --move %s %s", quote(sb.stage), quote(sb.dir)),
+		fmt.Sprintf(`for m in $(awk '{print $2}' /proc/self/mounts); do case "$m" in %s|%s|/proc|/proc/*|/dev|/dev/*|/sys|/sys/*) ;; *) mount -o remount,bind,ro "$m" 2>/dev/null || true ;; esac; done`, quote(sb.dir), quote(sb.home)),
+		"mount -t proc proc /proc",
+		"ip link set lo up 2>/dev/null || true",
+		"hostname demoit 2>/dev/null || true",
+		"set +e",
+		fmt.Sprintf("export HOME=%s TMPDIR=%s", quote(sb.home), quote(sb.home)),
+		shellCommand,
+	)
+
+	cmd := exec.Command("sh", "-c", strings.Join(setup, "\n"))
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags:  syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS | syscall.CLONE_NEWPID | syscall.CLONE_NEWNET | syscall.CLONE_NEWUTS | syscall.CLONE_NEWIPC,
+		UidMappings: []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getuid(), Size: 1}},
+		GidMappings: []syscall.SysProcIDMap{{ContainerID: 0, HostID: os.Getgid(), Size: 1}},
+	}
+	if sb.cgroup != nil {
+		cmd.SysProcAttr.UseCgroupFD = true
+		cmd.SysProcAttr.CgroupFD = int(sb.cgroup.Fd())
+	}
+
+	return cmd, nil
+}
+
+// overlayPath escapes a path for the options of an overlay mount,
+// where commas separate the options and colons the lower folders.
+func overlayPath(path string) string {
+	return strings.NewReplacer(`\`, `\\`, ",", `\,`, ":", `\:`).Replace(path)
+}
+
+// hiddenDirs lists the home folders hidden from the sandbox: the current
+// user's, root's and the other users'.
+func hiddenDirs() []string {
+	dirs := []string{"/root", "/home"}
+	if home, err := os.UserHomeDir(); err == nil && filepath.IsAbs(home) && home != "/" {
+		home = filepath.Clean(home)
+		if !isInDir(home, "/root") && !isInDir(home, "/home") {
+			dirs = append(dirs, home)
+		}
+	}
+
+	return dirs
+}
+
+// isInDir tests if a path is a folder or one of its descendants.
+func isInDir(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// release removes the sandbox's cgroup. The copy-on-write layer is
+// removed along with the session's folder.
+func (sb *sandbox) release() {
+	if sb.cgroup == nil {
+		return
+	}
+
+	_ = sb.cgroup.Close()
+	if err := os.Remove(sb.cgroup.Name()); err != nil {
+		fmt.Fprintln(os.Stderr, "unable to remove cgroup", sb.cgroup.Name(), err)
+	}
+}
+
+// createCgroup creates a cgroup v2, below the current process's cgroup,
+// with the sandbox's limits. It returns nil if there are no limits.
+func createCgroup(name string, limits *Sandbox) (*os.File, error) {
+	files := map[string]string{
+		"memory.max": limits.MemoryMax,
+		"pids.max":   limits.PidsMax,
+		"cpu.max":    limits.CPUMax,
+	}
+	var controllers []string
+	for file, value := range files {
+		if value == "" {
+			delete(files, file)
+			continue
+		}
+		controllers = append(controllers, "+"+strings.TrimSuffix(file, ".max"))
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	if _, err := os.Stat(filepath.Join(cgroupRoot, "cgroup.controllers")); err != nil {
+		return nil, errors.New("cgroups v2 are not available")
+	}
+
+	parent, err := currentCgroup()
+	if err != nil {
+		return nil, err
+	}
+
+	// Best effort: it fails if the controllers are already enabled or can't be delegated.
+	_ = os.WriteFile(filepath.Join(parent, "cgroup.subtree_control"), []byte(strings.Join(controllers, " ")), 0o644)
+
+	dir := filepath.Join(parent, "demoit-"+name)
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	for file, value := range files {
+		if err := os.WriteFile(filepath.Join(dir, file), []byte(value), 0o644); err != nil {
+			_ = os.Remove(dir)
+			return nil, err
+		}
+	}
+
+	return os.Open(dir)
+}
+
+// currentCgroup finds the cgroup v2 folder of the current process.
+func currentCgroup() (string, error) {
+	content, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return "", err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if path, found := strings.CutPrefix(scanner.Text(), "0::"); found {
+			return filepath.Join(cgroupRoot, path), nil
+		}
+	}
+
+	return "", errors.New("unable to find the current cgroup")
+}
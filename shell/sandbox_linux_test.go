@@ -0,0 +1,50 @@
+package shell
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSandboxHidesHome(t *testing.T) {
+	if err := exec.Command("unshare", "--user", "--map-root-user", "--mount", "true").Run(); err != nil {
+		t.Skip("user namespaces are not available")
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	if err := os.WriteFile(filepath.Join(home, "secret"), []byte("presenter-secret\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The deck can be in the presenter's home or elsewhere, with any name.
+	for _, dir := range []string{filepath.Join(home, "talk"), t.TempDir(), filepath.Join(t.TempDir(), `a deck, with:odd\chars`)} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "demo.txt"), []byte("deck-file\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := Run(Options{
+			Dir:     dir,
+			Shell:   "sh",
+			Env:     os.Environ(),
+			Sandbox: &Sandbox{},
+			Quiet:   true,
+		}, "cat demo.txt; cat "+quote(filepath.Join(home, "secret"))+" 2>&1; ls -A "+quote(home), 10*time.Second)
+		if err != nil {
+			t.Fatal(err, result.Output)
+		}
+
+		if !strings.Contains(result.Output, "deck-file") {
+			t.Errorf("the deck in %s is not visible:\n%s", dir, result.Output)
+		}
+		if strings.Contains(result.Output, "presenter-secret") {
+			t.Errorf("the presenter's home is visible:\n%s", result.Output)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+
+package shell
+
+import "errors"
+
+func newSandbox(_ *session, _ Options) (launcher, error) {
+	return nil, errors.New("sandboxed terminals are only supported on Linux")
+}
@@ -0,0 +1,352 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Options describe the shell started in a terminal.
+type Options struct {
+	// Dir is the folder the shell starts in.
+	Dir string
+	// Shell is the shell binary.
+	Shell string
+	// RC is the absolute path to an rc file sourced by the shell, if any.
+	RC string
+	// History is preloaded in the shell's history, if not empty.
+	History []byte
+	// Env is the environment of the shell.
+	Env []string
+	// KeepBackgroundJobs leaves the background jobs running when the
+	// terminal is closed. Only the shell is killed.
+	KeepBackgroundJobs bool
+	// Limits bound the number and the life of terminals.
+	Limits Limits
+	// Sandbox, if not nil, isolates the shell from the host.
+	Sandbox *Sandbox
+	// Tmux, if not empty, is the name of a tmux session to attach to,
+	// or to create, instead of starting a new shell.
+	Tmux string
+	// SSH, if not nil, is the remote host where the shell runs.
+	// Dir is then a remote folder and Env only has the variables to set.
+	SSH *SSHHost
+	// Quiet doesn't log how the terminal is started.
+	Quiet bool
+	// Replay, if not empty, is the path to an asciicast v2 recording
+	// played instead of running a shell.
+	Replay string
+	// Command, if not empty, is run by the shell instead of an
+	// interactive shell. Its exit status is printed when it's done.
+	Command string
+}
+
+const sessionPrefix = "demoit-session-"
+
+// liveSessions are the sessions whose temporary files are still in use.
+var liveSessions sync.Map
+
+// session is a running terminal. It owns the temporary files of the
+// terminal: rc file, history and zsh's ZDOTDIR. They all live in a
+// single folder, removed when the terminal exits.
+type session struct {
+	dir         string
+	description string
+
+	lock    sync.Mutex
+	process *os.Process
+}
+
+func newSession(opts Options) (*session, error) {
+	dir, err := os.MkdirTemp("", fmt.Sprintf("%s%d-*", sessionPrefix, os.Getpid()))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temp folder: %w", err)
+	}
+
+	s := &session{
+		dir:         dir,
+		description: filepath.Base(opts.Shell) + " in " + opts.Dir,
+	}
+	if opts.Tmux != "" {
+		s.description = "tmux session " + opts.Tmux + " in " + opts.Dir
+	}
+	if opts.Command != "" {
+		s.description = opts.Command + " in " + opts.Dir
+	}
+	if opts.Sandbox != nil {
+		s.description += ", sandboxed"
+	}
+	liveSessions.Store(s, struct{}{})
+
+	return s, nil
+}
+
+// started records the process running in the terminal's PTY.
+// The process leads its own session and process group.
+func (s *session) started(process *os.Process) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.process = process
+}
+
+// pid returns the pid of the process running in the terminal's PTY,
+// which is also its process group id, or 0 if it's not started yet.
+func (s *session) pid() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.process == nil {
+		return 0
+	}
+	return s.process.Pid
+}
+
+// hangup terminates a terminal whose WebSocket was closed. All the process
+// groups of the terminal's session are hung up, then killed if they don't
+// exit in time. To keep the background jobs running, only the shell is
+// killed and the session is remembered so that Shutdown can terminate it.
+func (s *session) hangup(keepBackgroundJobs bool, exited <-chan struct{}) {
+	pid := s.pid()
+
+	if keepBackgroundJobs {
+		_ = s.process.Kill()
+		<-exited
+		if isSessionAlive(pid) {
+			detachedSessions.Store(pid, s.description)
+		}
+		return
+	}
+
+	terminate([]int{pid}, hangupGrace)
+	<-exited
+}
+
+// close removes the temporary files of the session.
+func (s *session) close() {
+	liveSessions.Delete(s)
+	if err := os.RemoveAll(s.dir); err != nil {
+		fmt.Fprintln(os.Stderr, "unable to remove", s.dir, err)
+	}
+}
+
+// command builds the shell command that starts the shell, writing the
+// wrapper init files it needs in the session's folder.
+func (s *session) command(opts Options) (string, error) {
+	commands := []string{"cd " + quote(opts.Dir) + ">/dev/null"}
+
+	if opts.Command != "" {
+		if !opts.Quiet {
+			fmt.Println("Running", opts.Command, "in", opts.Dir)
+		}
+		return strings.Join(append(commands, runCommand(opts.Shell, opts.Command)), ";"), nil
+	}
+
+	if !opts.Quiet {
+		fmt.Println("Using shell", opts.Shell)
+		if opts.RC != "" {
+			fmt.Println("Using bashrc file", opts.RC)
+		}
+	}
+
+	// Copy history file (converting to zsh format if needed).
+	historyFile, err := s.copyHistoryFile(opts.Shell, opts.History)
+	if err != nil {
+		return "", err
+	}
+	if historyFile != "" && !opts.Quiet {
+		fmt.Println("Using history", historyFile)
+	}
+
+	// Build shell-specific exec command. Using a wrapper init file
+	// ensures HISTFILE is set after the shell's own startup files,
+	// which prevents the user's real history from overriding the demo history.
+	execCmd, err := s.shellExecCommand(opts.Shell, opts.RC, historyFile)
+	if err != nil {
+		return "", err
+	}
+	if opts.Tmux != "" {
+		if !opts.Quiet {
+			fmt.Println("Using tmux session", opts.Tmux, "of tmux -L", tmuxSocket())
+		}
+		execCmd = tmuxExecCommand(opts.Tmux, execCmd)
+	}
+	commands = append(commands, execCmd)
+
+	return strings.Join(commands, ";"), nil
+}
+
+// tmuxExecCommand attaches to a tmux session, creating it if needed with
+// the shell started by execCmd. The shell reads the session's temporary
+// files at startup so the tmux session can outlive them. When the terminal
+// is closed, the tmux client is hung up and detaches.
+//
+// The sessions live in a tmux server of their own, started by the first
+// terminal, so that its global environment is the terminal's, without
+// the scrubbed variables, and not the one of an existing server.
+func tmuxExecCommand(name, execCmd string) string {
+	tmuxUsed.Store(true)
+
+	// Unset TMUX in case demoit itself runs in tmux.
+	return "unset TMUX;exec tmux -L " + quote(tmuxSocket()) + " new-session -A -s " + quote(name) + " " + quote(execCmd)
+}
+
+// tmuxUsed tells if a terminal was attached to a tmux session.
+var tmuxUsed atomic.Bool
+
+// tmuxSocket is the name of the socket of demoit's tmux server.
+func tmuxSocket() string {
+	return fmt.Sprintf("demoit-%d", os.Getpid())
+}
+
+// stopTmux stops demoit's tmux server, if it was started.
+func stopTmux() {
+	if !tmuxUsed.Load() {
+		return
+	}
+
+	if err := exec.Command("tmux", "-L", tmuxSocket(), "kill-server").Run(); err == nil {
+		fmt.Println("Stopped the tmux sessions")
+	}
+}
+
+// runCommand runs a command with the shell, then prints its exit status,
+// dimmed, since the terminal shows the output until it's closed.
+func runCommand(shellBin, command string) string {
+	return shellBin + " -c " + quote(command) + `;printf '\n\033[90m[exit status %d]\033[0m\n' $?`
+}
+
+// shellExecCommand builds the exec command for the given shell, including
+// wrapper init files when needed to ensure HISTFILE survives shell startup.
+func (s *session) shellExecCommand(shellBin, bashRc, historyFile string) (string, error) {
+	switch filepath.Base(shellBin) {
+	case "bash":
+		return s.bashExecCommand(shellBin, bashRc, historyFile)
+	case "zsh":
+		return s.zshExecCommand(shellBin, bashRc, historyFile)
+	default:
+		return defaultExecCommand(shellBin, bashRc, historyFile), nil
+	}
+}
+
+// bashExecCommand creates an --rcfile that sources the user's .bashrc,
+// then demoit's .bashrc, then sets HISTFILE and reloads history.
+// This ensures HISTFILE is set after the user's startup files.
+func (s *session) bashExecCommand(shellBin, bashRc, historyFile string) (string, error) {
+	if bashRc == "" && historyFile == "" {
+		return "exec " + shellBin, nil
+	}
+
+	var rc strings.Builder
+	rc.WriteString("[ -f \"$HOME/.bashrc\" ] && source \"$HOME/.bashrc\"\n")
+	if bashRc != "" {
+		fmt.Fprintf(&rc, "source %q\n", bashRc)
+	}
+	if historyFile != "" {
+		fmt.Fprintf(&rc, "export HISTFILE=%q\n", historyFile)
+		rc.WriteString("history -r \"$HISTFILE\"\n")
+	}
+
+	rcFile, err := s.writeFile("bashrc", rc.String())
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("exec %s --rcfile %s", shellBin, rcFile), nil
+}
+
+// zshExecCommand creates a ZDOTDIR with .zshenv and .zshrc wrappers
+// that source the user's real startup files, then set HISTFILE.
+// This ensures HISTFILE is set after the user's startup files.
+func (s *session) zshExecCommand(shellBin, bashRc, historyFile string) (string, error) {
+	if bashRc == "" && historyFile == "" {
+		return "exec " + shellBin, nil
+	}
+
+	zdotdir := filepath.Join(s.dir, "zdotdir")
+	if err := os.Mkdir(zdotdir, 0o700); err != nil {
+		return "", fmt.Errorf("unable to create zdotdir: %w", err)
+	}
+
+	// .zshenv wrapper: source the user's real .zshenv.
+	zshenv := "[ -f \"$HOME/.zshenv\" ] && source \"$HOME/.zshenv\"\n"
+	if err := os.WriteFile(filepath.Join(zdotdir, ".zshenv"), []byte(zshenv), 0o600); err != nil {
+		return "", fmt.Errorf("unable to write .zshenv: %w", err)
+	}
+
+	// .zshrc wrapper: reset ZDOTDIR, source user's .zshrc, then demoit's, then set HISTFILE.
+	var zshrc strings.Builder
+	zshrc.WriteString("unset ZDOTDIR\n")
+	zshrc.WriteString("[ -f \"$HOME/.zshrc\" ] && source \"$HOME/.zshrc\"\n")
+	if bashRc != "" {
+		fmt.Fprintf(&zshrc, "source %q\n", bashRc)
+	}
+	if historyFile != "" {
+		fmt.Fprintf(&zshrc, "export HISTFILE=%q\n", historyFile)
+		zshrc.WriteString("fc -R \"$HISTFILE\"\n")
+	}
+
+	if err := os.WriteFile(filepath.Join(zdotdir, ".zshrc"), []byte(zshrc.String()), 0o600); err != nil {
+		return "", fmt.Errorf("unable to write .zshrc: %w", err)
+	}
+
+	return fmt.Sprintf("ZDOTDIR=%s exec %s", zdotdir, shellBin), nil
+}
+
+// defaultExecCommand builds a fallback exec command for unknown shells.
+// HISTFILE is set as an environment variable before exec, which may be
+// overridden by the shell's startup files.
+func defaultExecCommand(shellBin, bashRc, historyFile string) string {
+	var parts []string
+	if bashRc != "" {
+		parts = append(parts, "source "+bashRc)
+	}
+	if historyFile != "" {
+		parts = append(parts, fmt.Sprintf("HISTFILE=%s exec %s", historyFile, shellBin))
+	} else {
+		parts = append(parts, "exec "+shellBin)
+	}
+	return strings.Join(parts, ";")
+}
+
+// copyHistoryFile converts the history to zsh format if the shell is zsh,
+// and writes it to the session's folder.
+func (s *session) copyHistoryFile(shellBin string, content []byte) (string, error) {
+	if len(content) == 0 {
+		return "", nil
+	}
+
+	// Convert bash history to zsh extended history format if needed.
+	if filepath.Base(shellBin) == "zsh" {
+		content = convertToZshHistory(content)
+	}
+
+	return s.writeFile("history", string(content))
+}
+
+// convertToZshHistory converts bash history (one command per line) to
+// zsh extended history format (`: timestamp:0;command` per line).
+func convertToZshHistory(content []byte) []byte {
+	lines := strings.Split(string(content), "\n")
+	var buf strings.Builder
+	for _, line := range lines {
+		if line == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, ": 0:0;%s\n", line)
+	}
+	return []byte(buf.String())
+}
+
+// writeFile creates a file with the given content in the session's folder and returns its path.
+func (s *session) writeFile(name, content string) (string, error) {
+	path := filepath.Join(s.dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		return "", fmt.Errorf("unable to write temp file: %w", err)
+	}
+	return path, nil
+}
@@ -1,15 +1,15 @@
 package shell
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
-	"os/exec"
 	"sync"
+	"time"
 
-	"github.com/creack/pty"
 	"github.com/gorilla/websocket"
 )
 
@@ -25,8 +25,27 @@ var upgrader = websocket.Upgrader{
 }
 
 // HandleWebSocket upgrades an HTTP connection to a WebSocket and bridges
-// it to a PTY running the given shell command.
-func HandleWebSocket(w http.ResponseWriter, r *http.Request, shellCommand string) {
+// it to a terminal backend: a local shell by default. When the WebSocket
+// is closed, the backend is closed too. For a local shell, the whole
+// terminal is hung up: the shell and every job it started.
+//
+// The terminal is also closed when the browser stops answering pings,
+// when it hits one of the limits or when the request is cancelled, e.g.
+// on shutdown.
+func HandleWebSocket(w http.ResponseWriter, r *http.Request, opts Options) {
+	if err := opts.Limits.acquire(); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer opts.Limits.release()
+
+	backend, err := newBackend(opts)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer backend.Close()
+
 	conn, err := upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Println("WebSocket upgrade failed:", err)
@@ -34,34 +53,66 @@ func HandleWebSocket(w http.ResponseWriter, r *http.Request, shellCommand string
 	}
 	defer conn.Close()
 
-	ctx := r.Context()
-
-	// Start shell in a PTY.
-	cmd := exec.CommandContext(ctx, "sh", "-c", shellCommand)
-	cmd.Env = append(os.Environ(), "TERM=xterm-256color")
-
-	ptmx, err := pty.StartWithSize(cmd, &pty.Winsize{Rows: 24, Cols: 80})
-	if err != nil {
-		log.Println("PTY start failed:", err)
+	if err := backend.Start(80, 24); err != nil {
+		log.Println("Unable to start terminal:", err)
+		_ = conn.WriteMessage(websocket.BinaryMessage, bannerText("Unable to start terminal: "+err.Error()))
 		return
 	}
-	defer func() {
-		_ = ptmx.Close()
-		_ = cmd.Process.Kill()
-		_, _ = cmd.Process.Wait()
-	}()
 
+	bridge(r.Context(), conn, backend, opts.Limits)
+}
+
+// bridge copies the backend's output to the WebSocket and the WebSocket's
+// messages, input or resize requests, to the backend, until either side
+// is closed or the context is cancelled.
+func bridge(ctx context.Context, conn *websocket.Conn, backend Backend, limits Limits) {
 	var once sync.Once
 	done := make(chan struct{})
 	closeDone := func() { close(done) }
 
-	// PTY → WebSocket.
+	// Only one goroutine at a time can write messages.
+	var writeLock sync.Mutex
+	write := func(data []byte) error {
+		writeLock.Lock()
+		defer writeLock.Unlock()
+		return conn.WriteMessage(websocket.BinaryMessage, data)
+	}
+
+	// Close idle terminals and terminals open for too long.
+	activity := make(chan struct{}, 1)
+	go limits.watch(activity, done,
+		func(message string) { _ = write(bannerText(message)) },
+		func() { once.Do(closeDone) },
+	)
+
+	// Ping the browser. A tab that's gone doesn't answer and the read deadline expires.
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+	go func() {
+		ticker := time.NewTicker(pingPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(pingPeriod)); err != nil {
+					once.Do(closeDone)
+					return
+				}
+			}
+		}
+	}()
+
+	// Backend → WebSocket.
 	go func() {
 		buf := make([]byte, 4096)
 		for {
-			n, readErr := ptmx.Read(buf)
+			n, readErr := backend.Read(buf)
 			if n > 0 {
-				if writeErr := conn.WriteMessage(websocket.BinaryMessage, buf[:n]); writeErr != nil {
+				if writeErr := write(buf[:n]); writeErr != nil {
 					once.Do(closeDone)
 					return
 				}
@@ -73,7 +124,7 @@ func HandleWebSocket(w http.ResponseWriter, r *http.Request, shellCommand string
 		}
 	}()
 
-	// WebSocket → PTY.
+	// WebSocket → Backend.
 	go func() {
 		for {
 			_, message, readErr := conn.ReadMessage()
@@ -81,12 +132,18 @@ func HandleWebSocket(w http.ResponseWriter, r *http.Request, shellCommand string
 				once.Do(closeDone)
 				return
 			}
+			_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+
+			select {
+			case activity <- struct{}{}:
+			default:
+			}
 
 			// Try to parse as a resize message.
 			var resize resizeMessage
 			if json.Unmarshal(message, &resize) == nil && resize.Type == "resize" {
 				if resize.Cols > 0 && resize.Rows > 0 {
-					if err := pty.Setsize(ptmx, &pty.Winsize{Rows: resize.Rows, Cols: resize.Cols}); err != nil {
+					if err := backend.Resize(resize.Cols, resize.Rows); err != nil {
 						fmt.Fprintln(os.Stderr, "resize failed:", err)
 					}
 				}
@@ -94,12 +151,16 @@ func HandleWebSocket(w http.ResponseWriter, r *http.Request, shellCommand string
 			}
 
 			// Otherwise it's terminal input.
-			if _, err := ptmx.Write(message); err != nil {
+			if _, err := backend.Write(message); err != nil {
 				once.Do(closeDone)
 				return
 			}
 		}
 	}()
 
-	<-done
+	select {
+	case <-done:
+	case <-ctx.Done():
+		once.Do(closeDone)
+	}
 }
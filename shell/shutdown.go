@@ -0,0 +1,90 @@
+package shell
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// hangupGrace is how long a closed terminal has to exit after SIGHUP, before it's killed.
+const hangupGrace = 2 * time.Second
+
+// detachedSessions are the sessions of closed terminals whose background
+// jobs were kept running. They map session ids to descriptions.
+var detachedSessions sync.Map
+
+// Shutdown terminates all the terminals, including the background jobs
+// kept running after their terminal was closed, and the tmux sessions. The process groups of each
+// terminal receive SIGHUP then, if still alive after the grace period,
+// SIGKILL. A summary of what was terminated is printed.
+func Shutdown(grace time.Duration) {
+	sessions := map[int]string{}
+	liveSessions.Range(func(key, _ any) bool {
+		if s, ok := key.(*session); ok {
+			if pid := s.pid(); pid > 0 {
+				sessions[pid] = s.description
+			}
+		}
+		return true
+	})
+	detachedSessions.Range(func(key, value any) bool {
+		sid, _ := key.(int)
+		description, _ := value.(string)
+		if isSessionAlive(sid) {
+			sessions[sid] = description + ", background jobs"
+		}
+		return true
+	})
+
+	killed := terminate(slices.Collect(maps.Keys(sessions)), grace)
+
+	for _, sid := range slices.Sorted(maps.Keys(sessions)) {
+		outcome := "hung up"
+		if slices.Contains(killed, sid) {
+			outcome = "killed"
+		}
+		fmt.Printf("Terminal %d (%s): %s\n", sid, sessions[sid], outcome)
+	}
+	if len(sessions) > 0 {
+		fmt.Printf("Terminated %d terminal(s), %d killed after %s\n", len(sessions), len(killed), grace)
+	}
+	stopTmux()
+
+	Cleanup()
+}
+
+// terminate sends SIGHUP to all the process groups of the given sessions
+// then, after the grace period, SIGKILL to those still alive.
+// It returns the sessions that had to be killed.
+func terminate(sids []int, grace time.Duration) []int {
+	for _, sid := range sids {
+		signalSession(sid, syscall.SIGHUP)
+	}
+
+	deadline := time.Now().Add(grace)
+	for time.Now().Before(deadline) && len(aliveSessions(sids)) > 0 {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	killed := aliveSessions(sids)
+	for _, sid := range killed {
+		signalSession(sid, syscall.SIGKILL)
+	}
+
+	return killed
+}
+
+func aliveSessions(sids []int) []int {
+	var alive []int
+	for _, sid := range sids {
+		if isSessionAlive(sid) {
+			alive = append(alive, sid)
+		}
+	}
+	slices.Sort(alive)
+
+	return alive
+}
@@ -0,0 +1,27 @@
+//go:build !unix
+
+package shell
+
+import (
+	"os"
+	"syscall"
+)
+
+// signalSession kills the session leader. Process groups and
+// signals are not supported on this platform.
+func signalSession(sid int, _ syscall.Signal) {
+	if process, err := os.FindProcess(sid); err == nil {
+		_ = process.Kill()
+	}
+}
+
+// isSessionAlive tests if the session leader can still be found.
+func isSessionAlive(sid int) bool {
+	process, err := os.FindProcess(sid)
+	if err != nil {
+		return false
+	}
+	_ = process.Release()
+
+	return true
+}
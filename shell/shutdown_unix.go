@@ -0,0 +1,23 @@
+//go:build unix
+
+package shell
+
+import "syscall"
+
+// signalSession sends a signal to every process group of a session.
+func signalSession(sid int, signal syscall.Signal) {
+	for _, pgid := range sessionGroups(sid) {
+		_ = syscall.Kill(-pgid, signal)
+	}
+}
+
+// isSessionAlive tests if a session still has processes.
+func isSessionAlive(sid int) bool {
+	for _, pgid := range sessionGroups(sid) {
+		if syscall.Kill(-pgid, 0) == nil {
+			return true
+		}
+	}
+
+	return false
+}
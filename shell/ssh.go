@@ -0,0 +1,253 @@
+package shell
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const sshTimeout = 10 * time.Second
+
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SSHHost is a remote host where the shell runs, over SSH.
+type SSHHost struct {
+	// Address is the host:port to connect to.
+	Address string
+	User    string
+	// KeyFile is a private key file. If empty, the ssh-agent is used.
+	KeyFile string
+	// KnownHosts is the file used to check the host's key.
+	KnownHosts string
+	// InsecureIgnoreHostKey skips the host key check.
+	InsecureIgnoreHostKey bool
+}
+
+// sshBackend runs a shell on a remote host, in an SSH session with a PTY.
+// For bash, the rc file, the environment variables and the history are
+// injected with a wrapper rc file written in a remote temp folder. Other
+// shells only get the environment variables.
+type sshBackend struct {
+	opts Options
+
+	agent   net.Conn
+	client  *ssh.Client
+	session *ssh.Session
+	stdin   io.WriteCloser
+	stdout  io.Reader
+}
+
+func newSSHBackend(opts Options) *sshBackend {
+	return &sshBackend{opts: opts}
+}
+
+func (b *sshBackend) Start(cols, rows uint16) error {
+	host := b.opts.SSH
+
+	auth, err := b.authMethods()
+	if err != nil {
+		return err
+	}
+
+	hostKeyCallback := ssh.InsecureIgnoreHostKey()
+	if !host.InsecureIgnoreHostKey {
+		if hostKeyCallback, err = knownhosts.New(host.KnownHosts); err != nil {
+			return fmt.Errorf("unable to read known hosts: %w", err)
+		}
+	}
+
+	b.client, err = ssh.Dial("tcp", host.Address, &ssh.ClientConfig{
+		User:            host.User,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         sshTimeout,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to connect to %s: %w", host.Address, err)
+	}
+
+	if b.session, err = b.client.NewSession(); err != nil {
+		return err
+	}
+	if b.stdin, err = b.session.StdinPipe(); err != nil {
+		return err
+	}
+	if b.stdout, err = b.session.StdoutPipe(); err != nil {
+		return err
+	}
+
+	modes := ssh.TerminalModes{
+		ssh.ECHO:          1,
+		ssh.TTY_OP_ISPEED: 14400,
+		ssh.TTY_OP_OSPEED: 14400,
+	}
+	if err := b.session.RequestPty("xterm-256color", int(rows), int(cols), modes); err != nil {
+		return fmt.Errorf("unable to allocate a PTY: %w", err)
+	}
+
+	command, err := b.remoteCommand()
+	if err != nil {
+		return err
+	}
+
+	if !b.opts.Quiet {
+		fmt.Println("Using shell", b.opts.Shell, "on", host.User+"@"+host.Address)
+	}
+	return b.session.Start(command)
+}
+
+// authMethods authenticates with the key file, if any, or the ssh-agent.
+func (b *sshBackend) authMethods() ([]ssh.AuthMethod, error) {
+	if keyFile := b.opts.SSH.KeyFile; keyFile != "" {
+		key, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read key: %w", err)
+		}
+
+		signer, err := ssh.ParsePrivateKey(key)
+		var passphraseErr *ssh.PassphraseMissingError
+		if errors.As(err, &passphraseErr) {
+			return nil, fmt.Errorf("key %s is protected by a passphrase, use the ssh-agent instead", keyFile)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse key: %w", err)
+		}
+
+		return []ssh.AuthMethod{ssh.PublicKeys(signer)}, nil
+	}
+
+	socket := os.Getenv("SSH_AUTH_SOCK")
+	if socket == "" {
+		return nil, errors.New("no key file configured and no ssh-agent running")
+	}
+
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to the ssh-agent: %w", err)
+	}
+	b.agent = conn
+
+	return []ssh.AuthMethod{ssh.PublicKeysCallback(agent.NewClient(conn).Signers)}, nil
+}
+
+// remoteCommand builds the command that starts the remote shell. It's run
+// with sh, whatever the user's login shell.
+func (b *sshBackend) remoteCommand() (string, error) {
+	var script []string
+	if b.opts.Dir != "" {
+		script = append(script, "cd "+quote(b.opts.Dir)+" || exit 1")
+	}
+
+	var exports []string
+	for _, entry := range b.opts.Env {
+		name, value, _ := strings.Cut(entry, "=")
+		if !envNameRegexp.MatchString(name) {
+			return "", fmt.Errorf("invalid variable name %q", name)
+		}
+		exports = append(exports, "export "+name+"="+doubleQuote(value))
+	}
+
+	if path.Base(b.opts.Shell) != "bash" {
+		script = append(script, exports...)
+		script = append(script, "exec "+quote(b.opts.Shell)+" -l")
+		return "sh -c " + quote(strings.Join(script, "\n")), nil
+	}
+
+	var rc strings.Builder
+	rc.WriteString("[ -f \"$HOME/.bashrc\" ] && source \"$HOME/.bashrc\"\n")
+	if b.opts.RC != "" {
+		content, err := os.ReadFile(b.opts.RC)
+		if err != nil {
+			return "", fmt.Errorf("unable to read %s: %w", filepath.Base(b.opts.RC), err)
+		}
+		rc.Write(content)
+		rc.WriteString("\n")
+	}
+	for _, export := range exports {
+		rc.WriteString(export + "\n")
+	}
+	if len(b.opts.History) > 0 {
+		rc.WriteString("history -c\nhistory -r \"$DEMOIT_DIR/history\"\nunset HISTFILE\n")
+	}
+	rc.WriteString("rm -rf \"$DEMOIT_DIR\"\nunset DEMOIT_DIR\n")
+
+	script = append(script,
+		`DEMOIT_DIR=$(mktemp -d) || exit 1`,
+		`export DEMOIT_DIR`,
+		heredoc(`"$DEMOIT_DIR/bashrc"`, rc.String()),
+	)
+	if len(b.opts.History) > 0 {
+		script = append(script, heredoc(`"$DEMOIT_DIR/history"`, string(b.opts.History)))
+	}
+	script = append(script, "exec "+quote(b.opts.Shell)+` --rcfile "$DEMOIT_DIR/bashrc"`)
+
+	return "sh -c " + quote(strings.Join(script, "\n")), nil
+}
+
+func (b *sshBackend) Resize(cols, rows uint16) error {
+	return b.session.WindowChange(int(rows), int(cols))
+}
+
+func (b *sshBackend) Read(p []byte) (int, error) {
+	return b.stdout.Read(p)
+}
+
+func (b *sshBackend) Write(p []byte) (int, error) {
+	return b.stdin.Write(p)
+}
+
+// Wait waits for the remote shell to exit and returns its exit code,
+// or -1 if it's unknown.
+func (b *sshBackend) Wait() int {
+	err := b.session.Wait()
+	var exitErr *ssh.ExitError
+	switch {
+	case err == nil:
+		return 0
+	case errors.As(err, &exitErr):
+		return exitErr.ExitStatus()
+	default:
+		return -1
+	}
+}
+
+// Close hangs up the remote shell and closes the connection.
+func (b *sshBackend) Close() error {
+	if b.session != nil {
+		_ = b.session.Signal(ssh.SIGHUP)
+		_ = b.session.Close()
+	}
+	if b.client != nil {
+		_ = b.client.Close()
+	}
+	if b.agent != nil {
+		_ = b.agent.Close()
+	}
+
+	return nil
+}
+
+// heredoc writes content to a file with a here-document.
+func heredoc(file, content string) string {
+	if !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+
+	return "cat > " + file + " <<'DEMOIT_EOF'\n" + content + "DEMOIT_EOF"
+}
+
+// doubleQuote quotes a value for sh, still expanding the variables it references.
+func doubleQuote(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "`", "\\`").Replace(value) + `"`
+}
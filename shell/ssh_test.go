@@ -0,0 +1,274 @@
+package shell
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/creack/pty"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestSSHBackend(t *testing.T) {
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("bash is not installed")
+	}
+
+	host := startSSHServer(t)
+
+	rc := filepath.Join(t.TempDir(), "bashrc")
+	if err := os.WriteFile(rc, []byte("RC_VAR=from-rc\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		opts     Options
+		input    string
+		expected []string
+		exitCode int
+	}{
+		{
+			name: "bash",
+			opts: Options{
+				Shell:   "bash",
+				RC:      rc,
+				History: []byte("echo from-history\n"),
+				Env:     []string{"GREETING=hello world"},
+			},
+			input:    "echo \"$GREETING/$RC_VAR/$TERM\" tty:$(tty >/dev/null && echo yes); history; exit 3\n",
+			expected: []string{"hello world/from-rc/xterm-256color", "tty:yes", "echo from-history"},
+			exitCode: 3,
+		},
+		{
+			name: "other shell",
+			opts: Options{
+				Shell: "sh",
+				Dir:   "/",
+				Env:   []string{"GREETING=hello world"},
+			},
+			input:    "echo \"v=$GREETING\" in:$(pwd); exit 5\n",
+			expected: []string{"v=hello world", "in:/"},
+			exitCode: 5,
+		},
+		{
+			name: "quoted shell",
+			opts: Options{
+				Shell: "sh; exit 7",
+			},
+			exitCode: 127,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := test.opts
+			opts.SSH = host
+			opts.Quiet = true
+
+			backend := newSSHBackend(opts)
+			defer backend.Close()
+			if err := backend.Start(80, 24); err != nil {
+				t.Fatal(err)
+			}
+			if test.input != "" {
+				if _, err := backend.Write([]byte(test.input)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			output := readAll(t, backend)
+			for _, expected := range test.expected {
+				if !strings.Contains(output, expected) {
+					t.Errorf("output doesn't contain %q:\n%s", expected, output)
+				}
+			}
+			if exitCode := backend.Wait(); exitCode != test.exitCode {
+				t.Errorf("exit code is %d, expected %d", exitCode, test.exitCode)
+			}
+		})
+	}
+}
+
+func TestSSHBackendInvalidVariable(t *testing.T) {
+	backend := newSSHBackend(Options{
+		Shell: "sh",
+		Env:   []string{"BAD NAME=1"},
+		SSH:   startSSHServer(t),
+		Quiet: true,
+	})
+	defer backend.Close()
+
+	if err := backend.Start(80, 24); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+// readAll reads the terminal until the remote shell exits.
+func readAll(t *testing.T, backend *sshBackend) string {
+	t.Helper()
+
+	output := make(chan string, 1)
+	go func() {
+		content, _ := io.ReadAll(backend)
+		output <- string(content)
+	}()
+
+	select {
+	case content := <-output:
+		return content
+	case <-time.After(10 * time.Second):
+		t.Fatal("the remote shell didn't exit")
+		return ""
+	}
+}
+
+// startSSHServer starts an SSH server that runs the exec requests with
+// sh, in a PTY, and returns how to connect to it.
+func startSSHServer(t *testing.T) *SSHHost {
+	t.Helper()
+
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientPublicKey, clientKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorizedKey, err := ssh.NewPublicKey(clientPublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if string(key.Marshal()) != string(authorizedKey.Marshal()) {
+				return nil, errors.New("unknown key")
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSSH(conn, config)
+		}
+	}()
+
+	dir := t.TempDir()
+	block, err := ssh.MarshalPrivateKey(clientKey, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(dir, "id_ed25519")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	address := listener.Addr().String()
+	knownHosts := filepath.Join(dir, "known_hosts")
+	line := knownhosts.Line([]string{knownhosts.Normalize(address)}, hostSigner.PublicKey())
+	if err := os.WriteFile(knownHosts, []byte(line+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return &SSHHost{
+		Address:    address,
+		User:       "demo",
+		KeyFile:    keyFile,
+		KnownHosts: knownHosts,
+	}
+}
+
+func serveSSH(conn net.Conn, config *ssh.ServerConfig) {
+	_, channels, requests, err := ssh.NewServerConn(conn, config)
+	if err != nil {
+		return
+	}
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go serveSession(channel, requests)
+	}
+}
+
+// serveSession serves the requests of an SSH session: pty-req then exec.
+func serveSession(channel ssh.Channel, requests <-chan *ssh.Request) {
+	var term string
+	var size pty.Winsize
+	for request := range requests {
+		switch request.Type {
+		case "pty-req":
+			var payload struct {
+				Term          string
+				Columns, Rows uint32
+				Width, Height uint32
+				Modes         string
+			}
+			if ssh.Unmarshal(request.Payload, &payload) != nil {
+				_ = request.Reply(false, nil)
+				continue
+			}
+			term = payload.Term
+			size = pty.Winsize{Cols: uint16(payload.Columns), Rows: uint16(payload.Rows)}
+			_ = request.Reply(true, nil)
+		case "exec":
+			var payload struct{ Command string }
+			if term == "" || ssh.Unmarshal(request.Payload, &payload) != nil {
+				_ = request.Reply(false, nil)
+				continue
+			}
+
+			cmd := exec.Command("sh", "-c", payload.Command)
+			cmd.Env = append(os.Environ(), "TERM="+term)
+			ptmx, err := pty.StartWithSize(cmd, &size)
+			if err != nil {
+				_ = request.Reply(false, nil)
+				continue
+			}
+			_ = request.Reply(true, nil)
+
+			go func() { _, _ = io.Copy(ptmx, channel) }()
+			go func() {
+				_, _ = io.Copy(channel, ptmx)
+				_ = cmd.Wait()
+				status := make([]byte, 4)
+				binary.BigEndian.PutUint32(status, uint32(cmd.ProcessState.ExitCode()))
+				_, _ = channel.SendRequest("exit-status", false, status)
+				_ = channel.Close()
+			}()
+		default:
+			if request.WantReply {
+				_ = request.Reply(false, nil)
+			}
+		}
+	}
+}
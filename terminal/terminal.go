@@ -0,0 +1,402 @@
+// Package terminal describes the shells started by the <web-term> of a
+// deck, from their query parameters or attributes.
+package terminal
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dgageot/demoit/config"
+	"github.com/dgageot/demoit/files"
+	"github.com/dgageot/demoit/shell"
+)
+
+var (
+	tmuxSessionRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+	envNameRegexp     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// Query converts the attributes of a <web-term> into the query
+// parameters of its terminal, like the browser does.
+func Query(attrs map[string]string) url.Values {
+	query := url.Values{}
+	query.Set("folder", attrs["path"])
+	for _, name := range []string{"profile", "shell", "env", "replay", "host", "tmux"} {
+		if value, found := attrs[name]; found {
+			query.Set(name, value)
+		}
+	}
+	if value, found := attrs["keep-jobs"]; found {
+		if value == "" {
+			value = "true"
+		}
+		query.Set("keep_jobs", value)
+	}
+
+	return query
+}
+
+// Options describes the shell to start for the folder, profile,
+// shell, env, keep_jobs, host and tmux query parameters, the recording
+// to replay or the file to run.
+func Options(query url.Values) (shell.Options, error) {
+	dir := files.Root
+	if folder := query.Get("folder"); folder != "." && folder != "" {
+		dir = filepath.Join(dir, folder)
+	}
+
+	limits := shell.Limits{
+		MaxSessions: config.Current.Terminal.MaxSessions,
+		IdleTimeout: config.Current.Terminal.IdleTimeout,
+		MaxDuration: config.Current.Terminal.MaxDuration,
+	}
+
+	if name := query.Get("replay"); name != "" {
+		recording, err := recordingPath(name)
+		if err != nil {
+			return shell.Options{}, err
+		}
+		return shell.Options{Dir: dir, Replay: recording, Limits: limits}, nil
+	}
+
+	profile, err := terminalProfile(query.Get("profile"))
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	if file := query.Get("run"); file != "" {
+		return runOptions(file, query, profile, limits)
+	}
+
+	if name := query.Get("host"); name != "" {
+		// The sandbox doesn't apply to remote hosts and a <web-term> could
+		// bypass a sandboxed profile by not selecting it.
+		if config.Current.Terminal.Sandboxed() && !config.Current.Terminal.Sandbox.AllowHosts {
+			return shell.Options{}, fmt.Errorf("host %q is not sandboxed, set terminal.sandbox.allow_hosts to allow it", name)
+		}
+		return remoteOptions(name, query, profile, limits)
+	}
+
+	shellBin, err := terminalShell(query.Get("shell"), profile)
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	history, err := readHistory()
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	tmux := query.Get("tmux")
+	if tmux != "" {
+		if !tmuxSessionRegexp.MatchString(tmux) {
+			return shell.Options{}, fmt.Errorf("invalid tmux session name %q", tmux)
+		}
+		if _, err := exec.LookPath("tmux"); err != nil {
+			return shell.Options{}, errors.New("unable to find tmux")
+		}
+	}
+
+	keepJobs := config.Current.Terminal.KeepBackgroundJobs
+	if value := query.Get("keep_jobs"); value != "" {
+		if keepJobs, err = strconv.ParseBool(value); err != nil {
+			return shell.Options{}, fmt.Errorf("invalid keep_jobs %q", value)
+		}
+	}
+
+	env, err := terminalEnv(profile, query.Get("env"))
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	sandbox, err := terminalSandbox(profile)
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	return shell.Options{
+		Dir:                dir,
+		Shell:              shellBin,
+		RC:                 resolveFile(config.Current.Terminal.RCFile()),
+		History:            history,
+		Env:                env,
+		KeepBackgroundJobs: keepJobs,
+		Tmux:               tmux,
+		Limits:             limits,
+		Sandbox:            sandbox,
+	}, nil
+}
+
+// runOptions describes the shell that runs the configured command of a file,
+// in the file's folder. The file must be in the deck. Its name is in $FILE.
+func runOptions(file string, query url.Values, profile config.Profile, limits shell.Limits) (shell.Options, error) {
+	command, found := config.Current.Code.RunCommand(file)
+	if !found {
+		return shell.Options{}, fmt.Errorf("no command to run %s in %s", file, config.FileName)
+	}
+	path, err := files.InRoot(file)
+	if errors.Is(err, files.ErrOutsideRoot) {
+		return shell.Options{}, err
+	}
+	if err != nil {
+		return shell.Options{}, fmt.Errorf("file %q doesn't exist", file)
+	}
+	if info, err := os.Stat(path); err != nil || info.IsDir() {
+		return shell.Options{}, fmt.Errorf("file %q doesn't exist", file)
+	}
+
+	shellBin, err := terminalShell(query.Get("shell"), profile)
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	env, err := terminalEnv(profile, query.Get("env"))
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	sandbox, err := terminalSandbox(profile)
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	return shell.Options{
+		Dir:     filepath.Dir(files.Path(file)),
+		Shell:   shellBin,
+		Env:     append(env, "FILE="+filepath.Base(file)),
+		Command: command,
+		Limits:  limits,
+		Sandbox: sandbox,
+	}, nil
+}
+
+// terminalSandbox describes the sandbox of a terminal, or returns nil
+// if neither the configuration nor the profile require one. When some
+// profiles are sandboxed, the terminals that are not are refused, since
+// a client can select no profile at all.
+func terminalSandbox(profile config.Profile) (*shell.Sandbox, error) {
+	sandbox := config.Current.Terminal.Sandbox
+	if !sandbox.Enabled && !profile.Sandbox {
+		if config.Current.Terminal.Sandboxed() {
+			return nil, errors.New("terminals should use a sandboxed profile, since some profiles are sandboxed")
+		}
+		return nil, nil
+	}
+
+	var pidsMax string
+	if sandbox.PidsMax > 0 {
+		pidsMax = strconv.Itoa(sandbox.PidsMax)
+	}
+
+	return &shell.Sandbox{
+		MemoryMax: sandbox.MemoryMax,
+		PidsMax:   pidsMax,
+		CPUMax:    sandbox.CPUMax,
+	}, nil
+}
+
+// remoteOptions describes the shell to start on a configured host.
+// The folder is relative to the host's folder and the environment only
+// has the configured and requested variables.
+func remoteOptions(name string, query url.Values, profile config.Profile, limits shell.Limits) (shell.Options, error) {
+	host, found := config.Current.Hosts[name]
+	if !found {
+		return shell.Options{}, fmt.Errorf("unknown host %q", name)
+	}
+
+	dir := host.Dir
+	if folder := query.Get("folder"); folder != "." && folder != "" {
+		dir = path.Join(dir, folder)
+	}
+
+	shellBin := "bash"
+	for _, candidate := range []string{host.Shell, profile.Shell, query.Get("shell")} {
+		if candidate != "" {
+			shellBin = candidate
+		}
+	}
+
+	history, err := readHistory()
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	variables, err := requestedEnv(profile, query.Get("env"))
+	if err != nil {
+		return shell.Options{}, err
+	}
+
+	var env []string
+	for _, variable := range variables {
+		env = append(env, variable.name+"="+variable.value)
+	}
+
+	return shell.Options{
+		Dir:     dir,
+		Shell:   shellBin,
+		RC:      resolveFile(config.Current.Terminal.RCFile()),
+		History: history,
+		Env:     env,
+		Limits:  limits,
+		SSH: &shell.SSHHost{
+			Address:               host.SSHAddress(),
+			User:                  host.User,
+			KeyFile:               host.KeyFile(),
+			KnownHosts:            host.KnownHostsFile(),
+			InsecureIgnoreHostKey: host.InsecureIgnoreHostKey,
+		},
+	}, nil
+}
+
+// recordingPath finds a recording in the recordings folder.
+func recordingPath(name string) (string, error) {
+	folder := config.Current.RecordingsFolder()
+
+	path := filepath.Join(files.Path(folder), filepath.Clean("/"+name))
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("unable to find recording %s in %s", name, folder)
+	}
+
+	return path, nil
+}
+
+// readHistory reads the history file, .demoit/.bash_history by default.
+func readHistory() ([]byte, error) {
+	historyFile := config.Current.Terminal.HistoryFile()
+
+	content, err := os.ReadFile(files.Path(historyFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file %s: %w", historyFile, err)
+	}
+
+	return content, nil
+}
+
+// terminalProfile finds a terminal profile in the configuration.
+// An empty name is the default, empty, profile.
+func terminalProfile(name string) (config.Profile, error) {
+	if name == "" {
+		return config.Profile{}, nil
+	}
+
+	profile, found := config.Current.Terminal.Profiles[name]
+	if !found {
+		return config.Profile{}, fmt.Errorf("unknown terminal profile %q", name)
+	}
+
+	return profile, nil
+}
+
+// terminalShell selects the shell binary, by order of precedence: the one
+// requested by the <web-term>, the profile's, the configured one, $SHELL or bash.
+func terminalShell(requested string, profile config.Profile) (string, error) {
+	for _, shellBin := range []string{requested, profile.Shell, config.Current.Terminal.Shell, os.Getenv("SHELL")} {
+		if shellBin == "" {
+			continue
+		}
+		if _, err := exec.LookPath(shellBin); err != nil {
+			return "", fmt.Errorf("unable to find shell %q", shellBin)
+		}
+		return shellBin, nil
+	}
+
+	return "bash", nil
+}
+
+// terminalEnv builds the environment of a terminal: the inherited environment
+// minus the secrets, then the configured variables, the profile's and finally
+// the ones requested by the <web-term>. Values can reference other variables,
+// e.g. PATH=/opt/demo/bin:$PATH.
+func terminalEnv(profile config.Profile, requested string) ([]string, error) {
+	variables, err := requestedEnv(profile, requested)
+	if err != nil {
+		return nil, err
+	}
+
+	env := map[string]string{}
+	for _, entry := range os.Environ() {
+		name, value, _ := strings.Cut(entry, "=")
+		if !isSecret(name) {
+			env[name] = value
+		}
+	}
+
+	for _, variable := range variables {
+		env[variable.name] = os.Expand(variable.value, func(ref string) string { return env[ref] })
+	}
+
+	environ := make([]string, 0, len(env))
+	for _, name := range slices.Sorted(maps.Keys(env)) {
+		environ = append(environ, name+"="+env[name])
+	}
+
+	return environ, nil
+}
+
+type envVariable struct {
+	name, value string
+}
+
+// requestedEnv lists the variables set by the configuration, the profile
+// and the <web-term>, in that order. Every name must be a valid shell
+// variable name.
+func requestedEnv(profile config.Profile, requested string) ([]envVariable, error) {
+	var variables []envVariable
+	for _, name := range slices.Sorted(maps.Keys(config.Current.Terminal.Env)) {
+		variables = append(variables, envVariable{name, config.Current.Terminal.Env[name]})
+	}
+	for _, name := range slices.Sorted(maps.Keys(profile.Env)) {
+		variables = append(variables, envVariable{name, profile.Env[name]})
+	}
+	for entry := range strings.SplitSeq(requested, ";") {
+		if name, value, found := strings.Cut(strings.TrimSpace(entry), "="); found && name != "" {
+			variables = append(variables, envVariable{name, value})
+		}
+	}
+
+	for _, variable := range variables {
+		if !envNameRegexp.MatchString(variable.name) {
+			return nil, fmt.Errorf("invalid variable name %q", variable.name)
+		}
+	}
+
+	return variables, nil
+}
+
+// isSecret tests if an environment variable matches one of the patterns
+// of variables that should never be exposed in a terminal.
+func isSecret(name string) bool {
+	for _, pattern := range config.Current.Terminal.ScrubPatterns() {
+		if matched, _ := filepath.Match(strings.ToUpper(pattern), strings.ToUpper(name)); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveFile returns the absolute path to a file of the deck,
+// or an empty string if it doesn't exist.
+func resolveFile(name string) string {
+	path, err := filepath.Abs(files.Path(name))
+	if err != nil {
+		return ""
+	}
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
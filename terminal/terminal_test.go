@@ -0,0 +1,47 @@
+package terminal
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/dgageot/demoit/config"
+	"github.com/dgageot/demoit/files"
+)
+
+func TestSandboxedProfile(t *testing.T) {
+	previousRoot, previousConfig := files.Root, config.Current
+	t.Cleanup(func() { files.Root, config.Current = previousRoot, previousConfig })
+
+	files.Root = t.TempDir()
+	config.Current = config.Default()
+	config.Current.Terminal.Shell = "sh"
+	config.Current.Terminal.Profiles = map[string]config.Profile{"sb": {Sandbox: true}}
+	config.Current.Hosts = map[string]config.Host{"vm": {Address: "vm:22"}}
+
+	tests := []struct {
+		query     url.Values
+		sandboxed bool
+		refused   bool
+	}{
+		{query: url.Values{"profile": {"sb"}}, sandboxed: true},
+		{query: url.Values{}, refused: true},
+		{query: url.Values{"host": {"vm"}}, refused: true},
+		{query: url.Values{"profile": {"sb"}, "host": {"vm"}}, refused: true},
+	}
+	for _, test := range tests {
+		opts, err := Options(test.query)
+		if test.refused {
+			if err == nil {
+				t.Errorf("%v: expected an error", test.query)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", test.query, err)
+			continue
+		}
+		if sandboxed := opts.Sandbox != nil; sandboxed != test.sandboxed {
+			t.Errorf("%v: sandboxed is %t, expected %t", test.query, sandboxed, test.sandboxed)
+		}
+	}
+}